@@ -0,0 +1,325 @@
+package monopoly
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ActionKind is the kind of an [Action] a player can take.
+type ActionKind uint8
+
+const (
+	ACTION_ROLL_DICE ActionKind = iota
+	ACTION_MOVE
+	ACTION_CONTINUE
+	ACTION_BUY_PROPERTY
+	ACTION_DECLINE_PROPERTY
+	ACTION_BID
+	ACTION_PASS_AUCTION
+	ACTION_AUCTION_BUILDING
+	ACTION_MORTGAGE_PROPERTY
+	ACTION_CANCEL_MORTGAGE_PROPERTY
+	ACTION_BUY_HOUSE
+	ACTION_SELL_HOUSE
+	ACTION_TRANSFER_PROPERTY
+	ACTION_TRANSFER_JAIL_CARD
+	ACTION_PAY_JAIL_FINE
+	ACTION_USE_JAIL_CARD
+	ACTION_PAY_DEBTS
+	ACTION_DECLARE_BANKRUPTCY
+	ACTION_END_TURN
+	ACTION_PROPOSE_TRADE
+	ACTION_ACCEPT_TRADE
+	ACTION_REJECT_TRADE
+)
+
+// allActionKinds returns a slice of all action kinds.
+func allActionKinds() []ActionKind {
+	return []ActionKind{
+		ACTION_ROLL_DICE,
+		ACTION_MOVE,
+		ACTION_CONTINUE,
+		ACTION_BUY_PROPERTY,
+		ACTION_DECLINE_PROPERTY,
+		ACTION_BID,
+		ACTION_PASS_AUCTION,
+		ACTION_AUCTION_BUILDING,
+		ACTION_MORTGAGE_PROPERTY,
+		ACTION_CANCEL_MORTGAGE_PROPERTY,
+		ACTION_BUY_HOUSE,
+		ACTION_SELL_HOUSE,
+		ACTION_TRANSFER_PROPERTY,
+		ACTION_TRANSFER_JAIL_CARD,
+		ACTION_PAY_JAIL_FINE,
+		ACTION_USE_JAIL_CARD,
+		ACTION_PAY_DEBTS,
+		ACTION_DECLARE_BANKRUPTCY,
+		ACTION_END_TURN,
+		ACTION_PROPOSE_TRADE,
+		ACTION_ACCEPT_TRADE,
+		ACTION_REJECT_TRADE,
+	}
+}
+
+// GoString implements [fmt.GoStringer] interface.
+func (k ActionKind) GoString() string {
+	switch k {
+	case ACTION_ROLL_DICE:
+		return "ACTION_ROLL_DICE"
+	case ACTION_MOVE:
+		return "ACTION_MOVE"
+	case ACTION_CONTINUE:
+		return "ACTION_CONTINUE"
+	case ACTION_BUY_PROPERTY:
+		return "ACTION_BUY_PROPERTY"
+	case ACTION_DECLINE_PROPERTY:
+		return "ACTION_DECLINE_PROPERTY"
+	case ACTION_BID:
+		return "ACTION_BID"
+	case ACTION_PASS_AUCTION:
+		return "ACTION_PASS_AUCTION"
+	case ACTION_AUCTION_BUILDING:
+		return "ACTION_AUCTION_BUILDING"
+	case ACTION_MORTGAGE_PROPERTY:
+		return "ACTION_MORTGAGE_PROPERTY"
+	case ACTION_CANCEL_MORTGAGE_PROPERTY:
+		return "ACTION_CANCEL_MORTGAGE_PROPERTY"
+	case ACTION_BUY_HOUSE:
+		return "ACTION_BUY_HOUSE"
+	case ACTION_SELL_HOUSE:
+		return "ACTION_SELL_HOUSE"
+	case ACTION_TRANSFER_PROPERTY:
+		return "ACTION_TRANSFER_PROPERTY"
+	case ACTION_TRANSFER_JAIL_CARD:
+		return "ACTION_TRANSFER_JAIL_CARD"
+	case ACTION_PAY_JAIL_FINE:
+		return "ACTION_PAY_JAIL_FINE"
+	case ACTION_USE_JAIL_CARD:
+		return "ACTION_USE_JAIL_CARD"
+	case ACTION_PAY_DEBTS:
+		return "ACTION_PAY_DEBTS"
+	case ACTION_DECLARE_BANKRUPTCY:
+		return "ACTION_DECLARE_BANKRUPTCY"
+	case ACTION_END_TURN:
+		return "ACTION_END_TURN"
+	case ACTION_PROPOSE_TRADE:
+		return "ACTION_PROPOSE_TRADE"
+	case ACTION_ACCEPT_TRADE:
+		return "ACTION_ACCEPT_TRADE"
+	case ACTION_REJECT_TRADE:
+		return "ACTION_REJECT_TRADE"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// MarshalText implements [encoding.TextMarshaler] interface. The text is the lower case name of k
+// without the prefix, e.g. "roll_dice".
+func (k ActionKind) MarshalText() ([]byte, error) {
+	name := k.GoString()
+	if name == "UNKNOWN" {
+		return nil, fmt.Errorf("unknown action kind %d", k)
+	}
+	return []byte(strings.ToLower(strings.TrimPrefix(name, "ACTION_"))), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler] interface.
+func (k *ActionKind) UnmarshalText(text []byte) error {
+	for _, kind := range allActionKinds() {
+		if name, _ := kind.MarshalText(); string(name) == string(text) {
+			*k = kind
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown action kind %q", text)
+}
+
+// Action is a single action a player takes with [Game.Apply]. Only the fields needed by Kind are set:
+//   - Property for mortgaging, building and transferring properties
+//   - Building for building auctions
+//   - Card for transferring Get Out of Jail Free cards
+//   - Target and Amount for transfers, where Target is the receiving player who pays Amount
+//   - Amount for bids
+//   - Target and Trade for trade proposals
+type Action struct {
+	Kind     ActionKind `json:"kind"`
+	Player   Token      `json:"player"`
+	Property Property   `json:"property,omitempty"`
+	Building Building   `json:"building,omitempty"`
+	Card     Card       `json:"card,omitempty"`
+	Target   Token      `json:"target,omitempty"`
+	Amount   int        `json:"amount,omitempty"`
+	Trade    *Trade     `json:"trade,omitempty"`
+}
+
+// LogEntry is an [Action] in an [ActionLog] together with all random values drawn while it was
+// applied, e.g. the dice.
+type LogEntry struct {
+	Action
+	Draws []int `json:"draws,omitempty"`
+}
+
+// ActionLog records everything needed to replay a game: the rules, the players, the random values
+// drawn when setting up the game and every action afterwards. It can be stored as JSON and replayed
+// with [NewReplay].
+type ActionLog struct {
+	Rules   Rules      `json:"rules"`
+	Players []Token    `json:"players"`
+	Setup   []int      `json:"setup"`
+	Actions []LogEntry `json:"actions"`
+}
+
+// addDraw records the random value v for the last action, or for the setup before any action.
+func (l *ActionLog) addDraw(v int) {
+	if len(l.Actions) == 0 {
+		l.Setup = append(l.Setup, v)
+		return
+	}
+	last := &l.Actions[len(l.Actions)-1]
+	last.Draws = append(last.Draws, v)
+}
+
+// clone returns a deep copy of l.
+func (l *ActionLog) clone() *ActionLog {
+	c := &ActionLog{
+		Rules:   l.Rules,
+		Players: append([]Token(nil), l.Players...),
+		Setup:   append([]int(nil), l.Setup...),
+		Actions: make([]LogEntry, len(l.Actions)),
+	}
+	for i, e := range l.Actions {
+		c.Actions[i] = LogEntry{Action: e.Action, Draws: append([]int(nil), e.Draws...)}
+	}
+	return c
+}
+
+// Log returns a copy of the log of all actions taken in g so far and reports whether g has a log.
+// Games restored by [LoadGame] or [Game.UnmarshalBinary] have no log.
+func (g *Game) Log() (*ActionLog, bool) {
+	g.lock()
+	defer g.unlock()
+	if g.log == nil {
+		return nil, false
+	}
+	return g.log.clone(), true
+}
+
+// record adds a to the log of g and saves the state for undoing a. It is called by every action once
+// it is sure to succeed. Random values drawn afterwards belong to a.
+func (g *Game) record(a Action) {
+	g.saveUndo(a)
+	if g.log != nil {
+		g.log.Actions = append(g.log.Actions, LogEntry{Action: a})
+	}
+}
+
+// recordingSource draws random values from the games source and records them in the games log.
+// Actions that draw random values can't be undone.
+type recordingSource struct {
+	g *Game
+}
+
+// Intn implements [RandomSource] interface.
+func (r recordingSource) Intn(n int) int {
+	v := r.g.random.Intn(n)
+	r.g.undo = nil
+	if r.g.log != nil {
+		r.g.log.addDraw(v)
+	}
+	return v
+}
+
+// rng returns the source for all random values drawn in g.
+func (g *Game) rng() RandomSource {
+	return recordingSource{g}
+}
+
+// Apply makes the player of a take the action a and returns the events it caused. It is the single
+// entry point for front-ends like a network server or bots: a is validated against the current
+// state of the game, so callers don't need to know which [Player] method is valid when.
+func (g *Game) Apply(a Action) ([]Event, error) {
+	g.lock()
+	defer g.unlock()
+	if err := g.validate(a); err != nil {
+		return nil, err
+	}
+
+	n := len(g.events)
+	if err := g.apply(a); err != nil {
+		return nil, err
+	}
+	return append([]Event(nil), g.events[n:]...), nil
+}
+
+// apply makes the player of a take the action a. It expects g to be locked.
+func (g *Game) apply(a Action) error {
+	p := g.player(a.Player)
+	if p == nil {
+		return fmt.Errorf("%w: %#v", ErrUnknownPlayer, a.Player)
+	}
+
+	var err error
+	switch a.Kind {
+	case ACTION_ROLL_DICE:
+		_, _, _, err = p.rollDice()
+	case ACTION_MOVE:
+		_, err = p.move()
+	case ACTION_CONTINUE:
+		err = p.continueTurn()
+	case ACTION_BUY_PROPERTY:
+		_, err = p.buyProperty()
+	case ACTION_DECLINE_PROPERTY:
+		err = p.declineProperty()
+	case ACTION_BID:
+		err = p.bid(a.Amount)
+	case ACTION_PASS_AUCTION:
+		err = p.passAuction()
+	case ACTION_AUCTION_BUILDING:
+		err = p.auctionBuilding(a.Building)
+	case ACTION_MORTGAGE_PROPERTY:
+		err = p.mortgageProperty(a.Property)
+	case ACTION_CANCEL_MORTGAGE_PROPERTY:
+		err = p.cancelMortgageProperty(a.Property)
+	case ACTION_BUY_HOUSE:
+		_, err = p.buyHouse(a.Property)
+	case ACTION_SELL_HOUSE:
+		_, err = p.sellHouse(a.Property)
+	case ACTION_TRANSFER_PROPERTY, ACTION_TRANSFER_JAIL_CARD:
+		target := g.player(a.Target)
+		if target == nil {
+			return fmt.Errorf("%w: %#v", ErrUnknownPlayer, a.Target)
+		}
+		if a.Kind == ACTION_TRANSFER_PROPERTY {
+			err = p.transferProperty(target, a.Property, a.Amount)
+		} else {
+			err = p.transferJailCard(target, a.Card, a.Amount)
+		}
+	case ACTION_PAY_JAIL_FINE:
+		err = p.payJailFine()
+	case ACTION_USE_JAIL_CARD:
+		err = p.useJailCard()
+	case ACTION_PAY_DEBTS:
+		err = p.payDebts()
+	case ACTION_DECLARE_BANKRUPTCY:
+		err = p.declareBankruptcy()
+	case ACTION_END_TURN:
+		_, err = p.endTurn()
+	case ACTION_PROPOSE_TRADE:
+		target := g.player(a.Target)
+		if target == nil {
+			return fmt.Errorf("%w: %#v", ErrUnknownPlayer, a.Target)
+		}
+		var t Trade
+		if a.Trade != nil {
+			t = *a.Trade
+		}
+		err = p.proposeTrade(target, t)
+	case ACTION_ACCEPT_TRADE:
+		err = p.acceptTrade()
+	case ACTION_REJECT_TRADE:
+		err = p.rejectTrade()
+	default:
+		return fmt.Errorf("unknown action kind %d", a.Kind)
+	}
+	return err
+}
@@ -0,0 +1,47 @@
+package monopoly
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestGame_Apply(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG)
+	g.random = NewFixedDice(3, 4)
+	p, _ := g.GetCurrentPlayer()
+	other := g.players[1-g.currentTurn]
+
+	var stateErr *StateError
+	if _, err := g.Apply(Action{Kind: ACTION_END_TURN, Player: p.token}); !errors.As(err, &stateErr) {
+		t.Errorf("Game.Apply(END_TURN) at the start of the turn got error %v, want *StateError", err)
+	}
+	if _, err := g.Apply(Action{Kind: ACTION_ROLL_DICE, Player: other.token}); err != ErrNotYourTurn {
+		t.Errorf("Game.Apply(ROLL_DICE) out of turn got error %v, want %v", err, ErrNotYourTurn)
+	}
+	if _, err := g.Apply(Action{Kind: ACTION_ROLL_DICE, Player: HAT}); !errors.Is(err, ErrUnknownPlayer) {
+		t.Errorf("Game.Apply(ROLL_DICE) of an unknown player got error %v, want %v", err, ErrUnknownPlayer)
+	}
+
+	token, _ := p.token.MarshalText()
+	var a Action
+	if err := json.Unmarshal([]byte(`{"kind":"roll_dice","player":"`+string(token)+`"}`), &a); err != nil {
+		t.Fatalf("decoding action got error: %v", err)
+	}
+	events, err := g.Apply(a)
+	if err != nil {
+		t.Fatalf("Game.Apply(%#v) got error: %v", a, err)
+	}
+	want := DiceRolledEvent{Player: p, D1: 3, D2: 4}
+	if len(events) != 1 || events[0] != want {
+		t.Errorf("Game.Apply(%#v) got events %v, want [%v]", a, events, want)
+	}
+
+	events, err = g.Apply(Action{Kind: ACTION_MOVE, Player: p.token})
+	if err != nil {
+		t.Fatalf("Game.Apply(MOVE) got error: %v", err)
+	}
+	if len(events) == 0 || events[0] != (MovedEvent{Player: p, From: GO, To: Field(CHANCE_1)}) {
+		t.Errorf("Game.Apply(MOVE) got events %v, want a move to %#v first", events, Field(CHANCE_1))
+	}
+}
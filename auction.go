@@ -0,0 +1,233 @@
+package monopoly
+
+import "fmt"
+
+// Auction is an auction of either a property or a building the bank still owns. Every player may
+// bid on it until all but the highest bidder passed.
+type Auction struct {
+	property      Property // -1 if a building is auctioned
+	building      Building // -1 if a property is auctioned
+	resume        GameState
+	minIncrement  int
+	highestBid    int
+	highestBidder *Player
+	passed        map[*Player]bool
+}
+
+// Property returns the property that is auctioned and reports whether a property is auctioned at
+// all.
+func (a *Auction) Property() (Property, bool) {
+	return a.property, a.property != -1
+}
+
+// Building returns the kind of building that is auctioned and reports whether a building is
+// auctioned at all.
+func (a *Auction) Building() (Building, bool) {
+	return a.building, a.building != -1
+}
+
+// HighestBid returns the currently highest bid and the player who placed it. The player is nil if
+// no bid was placed yet.
+func (a *Auction) HighestBid() (int, *Player) {
+	return a.highestBid, a.highestBidder
+}
+
+// MinimumBid returns the lowest amount of money the next bid must have.
+func (a *Auction) MinimumBid() int {
+	if a.highestBidder == nil {
+		return a.minIncrement
+	}
+	return a.highestBid + a.minIncrement
+}
+
+// Passed reports whether p already passed in this auction.
+func (a *Auction) Passed(p *Player) bool {
+	return a.passed[p]
+}
+
+// Auction returns a copy of the currently running auction and reports whether there is one. The
+// copy doesn't change with further bids.
+func (g *Game) Auction() (*Auction, bool) {
+	g.lock()
+	defer g.unlock()
+	if g.auction == nil {
+		return nil, false
+	}
+	return g.auction.clone(), true
+}
+
+// clone returns a deep copy of a.
+func (a *Auction) clone() *Auction {
+	c := *a
+	c.passed = make(map[*Player]bool, len(a.passed))
+	for p, passed := range a.passed {
+		c.passed[p] = passed
+	}
+	return &c
+}
+
+// bidders returns all players who are still bidding in the current auction.
+func (g *Game) bidders() []*Player {
+	var bidders []*Player
+	for _, p := range g.players {
+		if !p.bankrupt && !g.auction.passed[p] {
+			bidders = append(bidders, p)
+		}
+	}
+	return bidders
+}
+
+// DeclineProperty makes p decline buying the property they are standing on. The property is then
+// auctioned among all players.
+func (p *Player) DeclineProperty() error {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.declineProperty()
+}
+
+// declineProperty is like DeclineProperty, but expects the game to be locked.
+func (p *Player) declineProperty() error {
+	if err := p.canDeclineProperty(); err != nil {
+		return err
+	}
+	p.game.record(Action{Kind: ACTION_DECLINE_PROPERTY, Player: p.token})
+
+	prop, _ := p.game.board.Property(p.position)
+	p.game.auction = &Auction{
+		property:     prop,
+		building:     -1,
+		resume:       GAME_TURN,
+		minIncrement: p.game.rules.AuctionMinIncrement,
+		passed:       map[*Player]bool{},
+	}
+	for _, other := range p.game.players {
+		if !other.mayBuy() {
+			p.game.auction.passed[other] = true
+		}
+	}
+	p.game.state = GAME_AUCTION
+	p.game.checkAuction()
+	return nil
+}
+
+// canDeclineProperty returns an error why p can't decline the property they are standing on.
+func (p *Player) canDeclineProperty() error {
+	if err := p.checkTurn(GAME_BUY_DECISION); err != nil {
+		return err
+	}
+	prop, ok := p.game.board.Property(p.position)
+	if !ok {
+		return ErrNotAProperty
+	}
+	if !p.game.isPropertyAvailable(prop) {
+		return ErrPropertyOwned
+	}
+	return nil
+}
+
+// Bid makes p bid amount of money in the current auction.
+func (p *Player) Bid(amount int) error {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.bid(amount)
+}
+
+// bid is like Bid, but expects the game to be locked.
+func (p *Player) bid(amount int) error {
+	if err := p.canBid(amount); err != nil {
+		return err
+	}
+	p.game.record(Action{Kind: ACTION_BID, Player: p.token, Amount: amount})
+
+	a := p.game.auction
+	a.highestBid = amount
+	a.highestBidder = p
+	p.game.checkAuction()
+	return nil
+}
+
+// canBid returns an error why p can't bid amount in the current auction.
+func (p *Player) canBid(amount int) error {
+	if err := p.checkBidder(); err != nil {
+		return err
+	}
+	if minimum := p.game.auction.MinimumBid(); amount < minimum {
+		return fmt.Errorf("%w: bid %d, minimum %d", ErrBidTooLow, amount, minimum)
+	}
+	return p.checkFunds(amount)
+}
+
+// PassAuction makes p stop bidding in the current auction. The highest bidder can't pass.
+func (p *Player) PassAuction() error {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.passAuction()
+}
+
+// passAuction is like PassAuction, but expects the game to be locked.
+func (p *Player) passAuction() error {
+	if err := p.canPassAuction(); err != nil {
+		return err
+	}
+	p.game.record(Action{Kind: ACTION_PASS_AUCTION, Player: p.token})
+
+	p.game.auction.passed[p] = true
+	p.game.checkAuction()
+	return nil
+}
+
+// canPassAuction returns an error why p can't pass in the current auction.
+func (p *Player) canPassAuction() error {
+	if err := p.checkBidder(); err != nil {
+		return err
+	}
+	if p.game.auction.highestBidder == p {
+		return ErrHighestBidder
+	}
+	return nil
+}
+
+// checkBidder returns an error if p is not allowed to take part in the current auction.
+func (p *Player) checkBidder() error {
+	if err := p.game.checkState(GAME_AUCTION); err != nil {
+		return err
+	}
+	if p.bankrupt {
+		return ErrBankrupt
+	}
+	if p.game.auction.passed[p] {
+		return ErrAlreadyPassed
+	}
+	return nil
+}
+
+// checkAuction ends the current auction when no other player is bidding against the highest bidder.
+// The highest bidder pays their bid to the bank and receives the property or building. If they
+// spent money since bidding, the missing amount becomes a debt. When every player passed without a
+// bid, it stays with the bank. When the bank ran out of the auctioned building meanwhile, e.g. by
+// replacing a sold hotel with houses, the auction ends without a winner.
+func (g *Game) checkAuction() {
+	a := g.auction
+	bidders := g.bidders()
+	if len(bidders) > 1 || len(bidders) == 1 && a.highestBidder == nil {
+		return
+	}
+
+	winner := a.highestBidder
+	if b, ok := a.Building(); ok && *g.supply(b) <= 0 {
+		winner = nil
+	}
+	if winner != nil {
+		winner.pay(a.highestBid, nil)
+		if b, ok := a.Building(); ok {
+			*g.supply(b)--
+			winner.reserved[b]++
+		} else {
+			winner.inventory[a.property] = STATE_NORMAL
+			g.emit(PropertyBoughtEvent{Player: winner, Property: a.property, Price: a.highestBid})
+		}
+	}
+	g.auction = nil
+	g.state = a.resume
+	g.checkDebts()
+}
@@ -0,0 +1,126 @@
+package monopoly
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAuction(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG, HAT)
+	p, _ := g.GetCurrentPlayer()
+	p.position = Field(BOARDWALK)
+	g.state = GAME_BUY_DECISION
+
+	if err := p.DeclineProperty(); err != nil {
+		t.Fatalf("Player.DeclineProperty() got error: %v", err)
+	}
+	a, ok := g.Auction()
+	if prop, _ := a.Property(); !ok || prop != BOARDWALK {
+		t.Fatalf("Game.Auction() got = (%#v, %t)", a, ok)
+	}
+
+	bidder1, bidder2 := g.players[(g.currentTurn+1)%3], g.players[(g.currentTurn+2)%3]
+	if err := bidder1.Bid(100); err != nil {
+		t.Fatalf("Player.Bid(100) got error: %v", err)
+	}
+	if err := bidder2.Bid(100 + defaultRules.AuctionMinIncrement - 1); !errors.Is(err, ErrBidTooLow) {
+		t.Errorf("Player.Bid() below the minimum increment got error %v, want %v", err, ErrBidTooLow)
+	}
+	if err := bidder2.Bid(150); err != nil {
+		t.Fatalf("Player.Bid(150) got error: %v", err)
+	}
+	if err := bidder2.PassAuction(); err != ErrHighestBidder {
+		t.Errorf("Player.PassAuction() by highest bidder got error %v, want %v", err, ErrHighestBidder)
+	}
+	p.PassAuction()
+	bidder1.PassAuction()
+
+	if _, ok := g.Auction(); ok || g.state != GAME_TURN {
+		t.Fatalf("auction still running, state %#v", g.state)
+	}
+	if owner, _, _ := g.GetPlayerForProperty(BOARDWALK); owner != bidder2 || bidder2.money != defaultRules.StartMoney-150 {
+		t.Errorf("auction winner is %#v with %d money, want %#v with %d", owner, bidder2.money, bidder2, defaultRules.StartMoney-150)
+	}
+}
+
+func TestPlayer_AuctionBuilding(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG)
+	p, other := g.players[0], g.players[1]
+	p.inventory[PARK_PLACE] = STATE_NORMAL
+	p.inventory[BOARDWALK] = STATE_NORMAL
+	other.inventory[MEDITERRANEAN_AVENUE] = STATE_NORMAL
+	other.inventory[BALTIC_AVENUE] = STATE_NORMAL
+	g.houses = 1
+	g.state = GAME_TURN
+
+	if !g.HousingShortage(HOUSE) {
+		t.Fatal("Game.HousingShortage(HOUSE) got = false, want true")
+	}
+	if _, err := p.BuyHouse(BOARDWALK); err != ErrHousingShortage {
+		t.Fatalf("Player.BuyHouse() during a housing shortage got error %v, want %v", err, ErrHousingShortage)
+	}
+	if err := p.AuctionBuilding(HOUSE); err != nil {
+		t.Fatalf("Player.AuctionBuilding(HOUSE) got error: %v", err)
+	}
+	p.Bid(120)
+	other.PassAuction()
+
+	if g.state != GAME_TURN || g.houses != 0 || p.ReservedBuildings(HOUSE) != 1 {
+		t.Fatalf("after auction: state = %#v, houses = %d, reserved = %d", g.state, g.houses, p.ReservedBuildings(HOUSE))
+	}
+	if _, err := p.BuyHouse(BOARDWALK); err != nil {
+		t.Fatalf("Player.BuyHouse() with a reserved house got error: %v", err)
+	}
+	if p.money != defaultRules.StartMoney-120 || p.ReservedBuildings(HOUSE) != 0 {
+		t.Errorf("after building: money = %d, reserved = %d", p.money, p.ReservedBuildings(HOUSE))
+	}
+	if _, err := other.BuyHouse(BALTIC_AVENUE); err != ErrNoBuildingsLeft {
+		t.Errorf("Player.BuyHouse() without houses left got error %v, want %v", err, ErrNoBuildingsLeft)
+	}
+}
+
+func TestPlayer_AuctionBuilding_soldOut(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG)
+	p, other := g.players[0], g.players[1]
+	p.inventory[PARK_PLACE] = STATE_NORMAL
+	p.inventory[BOARDWALK] = STATE_NORMAL
+	other.inventory[MEDITERRANEAN_AVENUE] = STATE_NORMAL
+	other.inventory[BALTIC_AVENUE] = STATE_NORMAL
+	g.houses = 1
+	g.state = GAME_TURN
+
+	if err := p.AuctionBuilding(HOUSE); err != nil {
+		t.Fatalf("Player.AuctionBuilding(HOUSE) got error: %v", err)
+	}
+	p.Bid(120)
+	g.houses = 0 // e.g. taken to replace a hotel sold during the auction
+	other.PassAuction()
+
+	if g.state != GAME_TURN || g.houses != 0 || p.ReservedBuildings(HOUSE) != 0 || p.money != defaultRules.StartMoney {
+		t.Errorf("after auction: state = %#v, houses = %d, reserved = %d, money = %d", g.state, g.houses, p.ReservedBuildings(HOUSE), p.money)
+	}
+}
+
+func TestAuction_spentAfterBidding(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG)
+	p, _ := g.GetCurrentPlayer()
+	bidder := g.players[1-g.currentTurn]
+	p.position = Field(BOARDWALK)
+	g.state = GAME_BUY_DECISION
+
+	p.DeclineProperty()
+	if err := bidder.Bid(300); err != nil {
+		t.Fatalf("Player.Bid(300) got error: %v", err)
+	}
+	bidder.money = 100 // e.g. spent on lifting mortgages during the auction
+	if err := p.PassAuction(); err != nil {
+		t.Fatalf("Player.PassAuction() got error: %v", err)
+	}
+
+	if bidder.money != 0 || bidder.Debt() != 200 {
+		t.Errorf("auction winner has %d money and %d debt, want 0 and 200", bidder.money, bidder.Debt())
+	}
+	if g.state != GAME_DEBT || g.resumeState != GAME_TURN {
+		t.Errorf("Game state got %#v resuming %#v, want %#v resuming %#v", g.state, g.resumeState, GAME_DEBT, GAME_TURN)
+	}
+}
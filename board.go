@@ -0,0 +1,378 @@
+package monopoly
+
+import (
+	_ "embed"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Kesuaheli/monopoly/lang"
+	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed boards/classic.yaml
+var classicBoard []byte
+
+var defaultBoard = mustParseBoard(classicBoard)
+
+// FieldKind is the kind of a field on the board. It defines what happens when a player lands on the
+// field.
+type FieldKind int8
+
+const (
+	KIND_GO FieldKind = iota
+	KIND_STREET
+	KIND_RAILROAD
+	KIND_UTILITY
+	KIND_TAX
+	KIND_CHANCE
+	KIND_COMMUNITY_CHEST
+	KIND_JAIL
+	KIND_FREE_PARKING
+	KIND_GO_TO_JAIL
+)
+
+// allFieldKinds returns a slice of all field kinds.
+func allFieldKinds() []FieldKind {
+	return []FieldKind{
+		KIND_GO,
+		KIND_STREET,
+		KIND_RAILROAD,
+		KIND_UTILITY,
+		KIND_TAX,
+		KIND_CHANCE,
+		KIND_COMMUNITY_CHEST,
+		KIND_JAIL,
+		KIND_FREE_PARKING,
+		KIND_GO_TO_JAIL,
+	}
+}
+
+// GoString implements [fmt.GoStringer] interface.
+func (k FieldKind) GoString() string {
+	switch k {
+	case KIND_GO:
+		return "KIND_GO"
+	case KIND_STREET:
+		return "KIND_STREET"
+	case KIND_RAILROAD:
+		return "KIND_RAILROAD"
+	case KIND_UTILITY:
+		return "KIND_UTILITY"
+	case KIND_TAX:
+		return "KIND_TAX"
+	case KIND_CHANCE:
+		return "KIND_CHANCE"
+	case KIND_COMMUNITY_CHEST:
+		return "KIND_COMMUNITY_CHEST"
+	case KIND_JAIL:
+		return "KIND_JAIL"
+	case KIND_FREE_PARKING:
+		return "KIND_FREE_PARKING"
+	case KIND_GO_TO_JAIL:
+		return "KIND_GO_TO_JAIL"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// MarshalText implements [encoding.TextMarshaler] interface. The text is the lower case name of k
+// without the prefix, e.g. "community_chest".
+func (k FieldKind) MarshalText() ([]byte, error) {
+	name := k.GoString()
+	if name == "UNKNOWN" {
+		return nil, fmt.Errorf("unknown field kind %d", k)
+	}
+	return []byte(strings.ToLower(strings.TrimPrefix(name, "KIND_"))), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler] interface.
+func (k *FieldKind) UnmarshalText(text []byte) error {
+	for _, kind := range allFieldKinds() {
+		if name, _ := kind.MarshalText(); string(name) == string(text) {
+			*k = kind
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown field kind %q", text)
+}
+
+// MarshalText implements [encoding.TextMarshaler] interface. The text is the lower case name of cg,
+// e.g. "light_blue".
+func (cg ColorGroup) MarshalText() ([]byte, error) {
+	if cg == -1 {
+		return nil, nil
+	}
+	name := cg.GoString()
+	if name == "UNKNOWN" {
+		return nil, fmt.Errorf("unknown color group %d", cg)
+	}
+	return []byte(strings.ToLower(name)), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler] interface.
+func (cg *ColorGroup) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*cg = -1
+		return nil
+	}
+	for _, group := range AllColorGroups() {
+		if name, _ := group.MarshalText(); string(name) == string(text) {
+			*cg = group
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown color group %q", text)
+}
+
+// Board describes all fields of a board. The classic board is returned by [DefaultBoard], other
+// boards can be loaded from YAML with [LoadBoard] or [ParseBoard].
+//
+// A board always has 40 fields. The engine identifies them by their position, so GO has to be the
+// first field and the jail the eleventh. All other fields can be arranged freely.
+type Board struct {
+	Name   string       `yaml:"name" json:"name"`
+	Fields []BoardField `yaml:"fields" json:"fields"`
+}
+
+// BoardField describes a single field of a [Board].
+type BoardField struct {
+	Kind FieldKind `yaml:"kind" json:"kind"`
+	// Name is the key of the fields name in the language files below "monopoly.field". If there is
+	// no such key, Name itself is used as the name.
+	Name string `yaml:"name" json:"name"`
+	// Group is the color group of a street and -1 for every other field.
+	Group ColorGroup `yaml:"group" json:"group"`
+	// Price is the price of a street, railroad or utility.
+	Price int `yaml:"price" json:"price,omitempty"`
+	// Rent is the rent of a street for every [PropertyState] from STATE_NORMAL to STATE_HOTEL, or the
+	// base rent of a railroad.
+	Rent []int `yaml:"rent" json:"rent,omitempty"`
+	// HouseCost is the price of a single house or hotel on a street.
+	HouseCost int `yaml:"house_cost" json:"house_cost,omitempty"`
+	// Tax is the amount of money to pay when landing on a tax field.
+	Tax int `yaml:"tax" json:"tax,omitempty"`
+}
+
+// UnmarshalYAML implements [yaml.Unmarshaler] interface. It defaults the color group to -1.
+func (bf *BoardField) UnmarshalYAML(node *yaml.Node) error {
+	type plain BoardField
+	f := plain{Group: -1}
+	if err := node.Decode(&f); err != nil {
+		return err
+	}
+	*bf = BoardField(f)
+	return nil
+}
+
+// DefaultBoard returns the classic board. The returned board is shared and must not be modified.
+func DefaultBoard() *Board {
+	return defaultBoard
+}
+
+// LoadBoard reads a board in YAML format from r and validates it.
+func LoadBoard(r io.Reader) (*Board, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read board: %v", err)
+	}
+	return ParseBoard(data)
+}
+
+// ParseBoard parses a board in YAML format and validates it.
+func ParseBoard(data []byte) (*Board, error) {
+	b := &Board{}
+	if err := yaml.Unmarshal(data, b); err != nil {
+		return nil, fmt.Errorf("parse board: %v", err)
+	}
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func mustParseBoard(data []byte) *Board {
+	b, err := ParseBoard(data)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// Validate checks whether b is a playable board.
+func (b *Board) Validate() error {
+	if len(b.Fields) != numberOfFields {
+		return fmt.Errorf("board %q has %d fields, want %d", b.Name, len(b.Fields), numberOfFields)
+	}
+	if b.Fields[GO].Kind != KIND_GO {
+		return fmt.Errorf("board %q: field %d must be %#v", b.Name, GO, KIND_GO)
+	}
+	if b.Fields[JUST_VISITING].Kind != KIND_JAIL {
+		return fmt.Errorf("board %q: field %d must be %#v", b.Name, JUST_VISITING, KIND_JAIL)
+	}
+
+	for i, f := range b.Fields {
+		if f.Name == "" {
+			return fmt.Errorf("board %q: field %d has no name", b.Name, i)
+		}
+		switch f.Kind {
+		case KIND_STREET:
+			if f.Group == -1 {
+				return fmt.Errorf("board %q: street %q has no color group", b.Name, f.Name)
+			}
+			if f.Price <= 0 || f.HouseCost <= 0 {
+				return fmt.Errorf("board %q: street %q needs a price and house cost", b.Name, f.Name)
+			}
+			if len(f.Rent) != int(STATE_HOTEL)+1 {
+				return fmt.Errorf("board %q: street %q has %d rents, want %d", b.Name, f.Name, len(f.Rent), STATE_HOTEL+1)
+			}
+		case KIND_RAILROAD:
+			if f.Price <= 0 || len(f.Rent) != 1 {
+				return fmt.Errorf("board %q: railroad %q needs a price and a single rent", b.Name, f.Name)
+			}
+		case KIND_UTILITY:
+			if f.Price <= 0 {
+				return fmt.Errorf("board %q: utility %q needs a price", b.Name, f.Name)
+			}
+		case KIND_GO, KIND_JAIL:
+			if i != int(GO) && i != int(JUST_VISITING) {
+				return fmt.Errorf("board %q: field %d can't be %#v", b.Name, i, f.Kind)
+			}
+		}
+	}
+	return nil
+}
+
+// Field returns the description of the field f. When f is IN_JAIL, the jail is returned.
+func (b *Board) Field(f Field) BoardField {
+	if f == IN_JAIL {
+		f = JUST_VISITING
+	}
+	return b.Fields[f]
+}
+
+// Kind returns the kind of the field f.
+func (b *Board) Kind(f Field) FieldKind {
+	return b.Field(f).Kind
+}
+
+// Property converts f into a [Property] and reports whether f is a street, railroad or utility on
+// b.
+func (b *Board) Property(f Field) (Property, bool) {
+	if f < 0 || int(f) >= numberOfFields {
+		return -1, false
+	}
+	switch b.Kind(f) {
+	case KIND_STREET, KIND_RAILROAD, KIND_UTILITY:
+		return Property(f), true
+	default:
+		return -1, false
+	}
+}
+
+// Price returns the price of prop.
+func (b *Board) Price(prop Property) int {
+	return b.Field(Field(prop)).Price
+}
+
+// MortgageValue returns the money the bank pays for mortgaging prop.
+func (b *Board) MortgageValue(prop Property) int {
+	return b.Price(prop) / 2
+}
+
+// HouseCost returns the price of a single house or hotel on prop.
+func (b *Board) HouseCost(prop Property) int {
+	return b.Field(Field(prop)).HouseCost
+}
+
+// Rent returns the rent for prop in the state ps, without taking the owners other properties into
+// account. For railroads this is the rent for a single railroad and for utilities it is always 0,
+// because it depends on the dice.
+func (b *Board) Rent(prop Property, ps PropertyState) int {
+	if ps == STATE_MORTGAGE {
+		return 0
+	}
+	f := b.Field(Field(prop))
+	switch f.Kind {
+	case KIND_STREET:
+		return f.Rent[ps]
+	case KIND_RAILROAD:
+		return f.Rent[0]
+	default:
+		return 0
+	}
+}
+
+// Tax returns the amount of money to pay when landing on the tax field f.
+func (b *Board) Tax(f Field) int {
+	return b.Field(f).Tax
+}
+
+// ColorGroup returns the color group of prop and reports whether prop is a street.
+func (b *Board) ColorGroup(prop Property) (ColorGroup, bool) {
+	f := b.Field(Field(prop))
+	return f.Group, f.Kind == KIND_STREET
+}
+
+// Properties returns all streets of the color group cg.
+func (b *Board) Properties(cg ColorGroup) []Property {
+	var props []Property
+	for i, f := range b.Fields {
+		if f.Kind == KIND_STREET && f.Group == cg {
+			props = append(props, Property(i))
+		}
+	}
+	return props
+}
+
+// fieldByName returns the field with the name and reports whether there is one.
+func (b *Board) fieldByName(name string) (Field, bool) {
+	for i, f := range b.Fields {
+		if f.Name == name {
+			return Field(i), true
+		}
+	}
+	return -1, false
+}
+
+// fieldsOfKind returns all fields of the kind k.
+func (b *Board) fieldsOfKind(k FieldKind) []Field {
+	var fields []Field
+	for i, f := range b.Fields {
+		if f.Kind == k {
+			fields = append(fields, Field(i))
+		}
+	}
+	return fields
+}
+
+// Localize returns the localized name of the field f in the language langTag.
+func (b *Board) Localize(f Field, langTag language.Tag) string {
+	if f == IN_JAIL {
+		return f.Localize(langTag)
+	}
+	name := b.Field(f).Name
+	key := "monopoly.field." + name
+	if localized := lang.MustLocalize(key, langTag); localized != key {
+		return localized
+	}
+	return name
+}
+
+// LocalizeInventory returns the localized names of all properties in inv together with their
+// state in the language langTag.
+func (b *Board) LocalizeInventory(inv Inventory, langTag language.Tag) string {
+	if len(inv) == 0 {
+		return "no properties"
+	}
+	var props []string
+	for prop, state := range inv {
+		if state == STATE_NORMAL {
+			props = append(props, b.Localize(Field(prop), langTag))
+		} else {
+			props = append(props, b.Localize(Field(prop), langTag)+" "+state.Localize(langTag))
+		}
+	}
+	return strings.Join(props, ", ")
+}
@@ -0,0 +1,72 @@
+package monopoly
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDefaultBoard(t *testing.T) {
+	b := DefaultBoard()
+	if err := b.Validate(); err != nil {
+		t.Fatalf("Board.Validate() of default board got error: %v", err)
+	}
+
+	tests := []struct {
+		field Field
+		kind  FieldKind
+	}{
+		{GO, KIND_GO},
+		{Field(MEDITERRANEAN_AVENUE), KIND_STREET},
+		{INCOME_TAX, KIND_TAX},
+		{Field(READING_RAILROAD), KIND_RAILROAD},
+		{CHANCE_1, KIND_CHANCE},
+		{JUST_VISITING, KIND_JAIL},
+		{IN_JAIL, KIND_JAIL},
+		{Field(ELECTRIC_COMPANY), KIND_UTILITY},
+		{COMMUNITY_CHEST_2, KIND_COMMUNITY_CHEST},
+		{FREE_PARKING, KIND_FREE_PARKING},
+		{GO_TO_JAIL, KIND_GO_TO_JAIL},
+		{LUXERY_TAX, KIND_TAX},
+	}
+	for _, tt := range tests {
+		if got := b.Kind(tt.field); got != tt.kind {
+			t.Errorf("Board.Kind(%#v) got = %#v, want %#v", tt.field, got, tt.kind)
+		}
+	}
+
+	if got := b.Price(BOARDWALK); got != 400 {
+		t.Errorf("Board.Price(BOARDWALK) got = %d, want 400", got)
+	}
+	if got := b.Rent(BOARDWALK, STATE_HOTEL); got != 2000 {
+		t.Errorf("Board.Rent(BOARDWALK, STATE_HOTEL) got = %d, want 2000", got)
+	}
+	if got := b.Tax(LUXERY_TAX); got != 75 {
+		t.Errorf("Board.Tax(LUXERY_TAX) got = %d, want 75", got)
+	}
+	if got := len(b.Properties(DARK_BLUE)); got != 2 {
+		t.Errorf("Board.Properties(DARK_BLUE) got %d streets, want 2", got)
+	}
+}
+
+func TestParseBoard(t *testing.T) {
+	data := string(classicBoard)
+	b, err := LoadBoard(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("LoadBoard() of classic board got error: %v", err)
+	}
+	if cg, _ := b.ColorGroup(PARK_PLACE); b.Price(PARK_PLACE) != 350 || cg != DARK_BLUE {
+		t.Errorf("ParseBoard() got different Park Place: %#v", b.Field(Field(PARK_PLACE)))
+	}
+
+	invalid := []string{
+		"name: empty",
+		strings.Replace(data, "kind: go,", "kind: tax,", 1),
+		strings.Replace(data, "kind: street", "kind: castle", 1),
+		strings.Replace(data, ", group: brown", "", 1),
+	}
+	for _, s := range invalid {
+		if _, err := ParseBoard([]byte(s)); err == nil {
+			t.Errorf("ParseBoard() of invalid board got no error")
+		}
+	}
+}
@@ -0,0 +1,582 @@
+package monopoly
+
+import (
+	"errors"
+	"slices"
+)
+
+// maxManageRounds limits how often a [Strategy] is asked for more actions within one decision, so
+// a strategy undoing its own actions can't stall the game.
+const maxManageRounds = 20
+
+// Strategy makes the decisions of a bot player. All methods get a read-only [View] of the game.
+// The actions returned by a strategy don't need to be legal, the [Driver] skips illegal ones.
+type Strategy interface {
+	// Buy decides whether to buy the property prop the player landed on. If not, it is auctioned.
+	Buy(v *View, prop Property) bool
+	// Bid returns the amount to bid in the running auction, or 0 to pass.
+	Bid(v *View) int
+	// Manage returns mortgages, lifted mortgages, houses to buy or sell and building auctions to
+	// start during a housing shortage. It is called before ending a turn and when the player has to
+	// pay debts, until it returns nothing that can be applied anymore.
+	Manage(v *View) []Action
+	// AcceptTrade decides whether to accept the trade t proposed by the player from.
+	AcceptTrade(v *View, from Token, t Trade) bool
+	// Jail decides how to get out of jail: [ACTION_ROLL_DICE], [ACTION_PAY_JAIL_FINE] or
+	// [ACTION_USE_JAIL_CARD].
+	Jail(v *View) ActionKind
+}
+
+// View is a read-only view of a game for a [Strategy]. It is only valid during the call it is
+// passed to.
+type View struct {
+	g *Game
+	p *Player
+}
+
+// Self returns the token of the player the strategy decides for.
+func (v *View) Self() Token {
+	return v.p.token
+}
+
+// State returns the state of the game.
+func (v *View) State() GameState {
+	return v.g.state
+}
+
+// Rules returns the rules of the game.
+func (v *View) Rules() Rules {
+	return v.g.rules
+}
+
+// Board returns the board of the game.
+func (v *View) Board() *Board {
+	return v.g.board
+}
+
+// Players returns the tokens of all players that are not bankrupt.
+func (v *View) Players() []Token {
+	var tokens []Token
+	for _, p := range v.g.players {
+		if !p.bankrupt {
+			tokens = append(tokens, p.token)
+		}
+	}
+	return tokens
+}
+
+// Money returns the money of the player with the token t.
+func (v *View) Money(t Token) int {
+	if p := v.g.player(t); p != nil {
+		return p.money
+	}
+	return 0
+}
+
+// Debt returns the total amount the player with the token t owes.
+func (v *View) Debt(t Token) int {
+	if p := v.g.player(t); p != nil {
+		return p.totalDebt()
+	}
+	return 0
+}
+
+// Position returns the field the player with the token t is on.
+func (v *View) Position(t Token) Field {
+	if p := v.g.player(t); p != nil {
+		return p.position
+	}
+	return GO
+}
+
+// Inventory returns a copy of the properties of the player with the token t.
+func (v *View) Inventory(t Token) Inventory {
+	inventory := Inventory{}
+	if p := v.g.player(t); p != nil {
+		for prop, state := range p.inventory {
+			inventory[prop] = state
+		}
+	}
+	return inventory
+}
+
+// Owner returns the owner of prop and its state and reports whether it is owned at all.
+func (v *View) Owner(prop Property) (Token, PropertyState, bool) {
+	p, state, ok := v.g.playerForProperty(prop)
+	if !ok {
+		return 0, state, false
+	}
+	return p.token, state, true
+}
+
+// JailCards returns the Get Out of Jail Free cards of the player with the token t.
+func (v *View) JailCards(t Token) []Card {
+	if p := v.g.player(t); p != nil {
+		return p.jailCards()
+	}
+	return nil
+}
+
+// AuctionedProperty returns the property in the running auction and reports whether a property is
+// auctioned.
+func (v *View) AuctionedProperty() (Property, bool) {
+	if v.g.auction == nil {
+		return 0, false
+	}
+	return v.g.auction.Property()
+}
+
+// AuctionedBuilding returns the building in the running auction and reports whether a building is
+// auctioned.
+func (v *View) AuctionedBuilding() (Building, bool) {
+	if v.g.auction == nil {
+		return 0, false
+	}
+	return v.g.auction.Building()
+}
+
+// MinimumBid returns the lowest valid bid in the running auction, or 0 if there is none.
+func (v *View) MinimumBid() int {
+	if v.g.auction == nil {
+		return 0
+	}
+	return v.g.auction.MinimumBid()
+}
+
+// LegalActions returns the actions the player can take right now, like [Game.LegalActions].
+func (v *View) LegalActions() []Action {
+	return v.p.legalActions()
+}
+
+// Driver lets bots play for some players of a game. Players without a bot are left to the caller.
+type Driver struct {
+	game *Game
+	bots map[Token]Strategy
+}
+
+// NewDriver returns a driver that plays for every player with a token in bots.
+func NewDriver(g *Game, bots map[Token]Strategy) *Driver {
+	d := &Driver{game: g, bots: make(map[Token]Strategy, len(bots))}
+	for t, s := range bots {
+		d.bots[t] = s
+	}
+	return d
+}
+
+// Step lets the next bot make one decision, e.g. to buy a property or to end its turn, and applies
+// the actions of it. It reports whether a bot acted. If not, the game is over or waits for a player
+// without a bot.
+func (d *Driver) Step() (bool, error) {
+	g := d.game
+	g.lock()
+	defer g.unlock()
+
+	p := d.next()
+	if p == nil {
+		return false, nil
+	}
+	return true, d.decide(p, d.bots[p.token])
+}
+
+// Run steps until no bot can act anymore and returns the number of steps. A maxSteps greater than
+// 0 stops the bots after that many steps.
+func (d *Driver) Run(maxSteps int) (int, error) {
+	var steps int
+	for maxSteps <= 0 || steps < maxSteps {
+		acted, err := d.Step()
+		if err != nil || !acted {
+			return steps, err
+		}
+		steps++
+	}
+	return steps, nil
+}
+
+// next returns the bot player that has to act next, or nil if it is no bots turn. It expects the
+// game to be locked.
+func (d *Driver) next() *Player {
+	g := d.game
+	isBot := func(p *Player) bool {
+		_, ok := d.bots[p.token]
+		return ok && !p.bankrupt
+	}
+
+	switch g.state {
+	case GAME_OVER:
+		return nil
+	case GAME_AUCTION:
+		for _, p := range g.players {
+			if isBot(p) && !g.auction.passed[p] && g.auction.highestBidder != p {
+				return p
+			}
+		}
+		return nil
+	case GAME_DEBT:
+		for _, p := range g.debtors() {
+			if isBot(p) {
+				return p
+			}
+		}
+		return nil
+	case GAME_TRADE:
+		if isBot(g.trade.To) {
+			return g.trade.To
+		}
+		return nil
+	}
+	if p := g.players[g.currentTurn]; isBot(p) {
+		return p
+	}
+	return nil
+}
+
+// decide asks s for the next decision of p and applies it. It expects the game to be locked.
+func (d *Driver) decide(p *Player, s Strategy) error {
+	g := d.game
+	v := &View{g: g, p: p}
+	apply := func(a Action) error {
+		a.Player = p.token
+		if err := g.validate(a); err != nil {
+			return err
+		}
+		return g.apply(a)
+	}
+
+	switch g.state {
+	case GAME_TURN_START, GAME_ROLLED_DICE, GAME_MOVED_TO_NEW_FIELD, GAME_CARD:
+		next := map[GameState]ActionKind{
+			GAME_TURN_START:         ACTION_ROLL_DICE,
+			GAME_ROLLED_DICE:        ACTION_MOVE,
+			GAME_MOVED_TO_NEW_FIELD: ACTION_CONTINUE,
+			GAME_CARD:               ACTION_CONTINUE,
+		}
+		return apply(Action{Kind: next[g.state]})
+	case GAME_IN_JAIL:
+		if k := s.Jail(v); k != ACTION_ROLL_DICE && apply(Action{Kind: k}) == nil {
+			return nil
+		}
+		if apply(Action{Kind: ACTION_ROLL_DICE}) == nil {
+			return nil
+		}
+		return apply(Action{Kind: ACTION_PAY_JAIL_FINE})
+	case GAME_BUY_DECISION:
+		if prop, ok := g.board.Property(p.position); ok && s.Buy(v, prop) && apply(Action{Kind: ACTION_BUY_PROPERTY}) == nil {
+			return nil
+		}
+		return apply(Action{Kind: ACTION_DECLINE_PROPERTY})
+	case GAME_AUCTION:
+		if amount := s.Bid(v); amount > 0 && apply(Action{Kind: ACTION_BID, Amount: amount}) == nil {
+			return nil
+		}
+		return apply(Action{Kind: ACTION_PASS_AUCTION})
+	case GAME_DEBT:
+		d.manage(v, s, apply)
+		if apply(Action{Kind: ACTION_PAY_DEBTS}) == nil {
+			return nil
+		}
+		if err := apply(Action{Kind: ACTION_DECLARE_BANKRUPTCY}); !errors.Is(err, ErrDebtsPayable) {
+			return err
+		}
+		d.liquidate(v, apply)
+		return apply(Action{Kind: ACTION_PAY_DEBTS})
+	case GAME_TRADE:
+		if s.AcceptTrade(v, g.trade.From.token, *g.trade.Trade.clone()) && apply(Action{Kind: ACTION_ACCEPT_TRADE}) == nil {
+			return nil
+		}
+		return apply(Action{Kind: ACTION_REJECT_TRADE})
+	case GAME_TURN:
+		d.manage(v, s, apply)
+		if g.state != GAME_TURN {
+			return nil // a building auction was started
+		}
+		return apply(Action{Kind: ACTION_END_TURN})
+	}
+	return &StateError{Got: g.state}
+}
+
+// manage applies the actions returned by [Strategy.Manage] until none of them can be applied.
+func (d *Driver) manage(v *View, s Strategy, apply func(Action) error) {
+	manageable := []ActionKind{ACTION_MORTGAGE_PROPERTY, ACTION_CANCEL_MORTGAGE_PROPERTY, ACTION_BUY_HOUSE, ACTION_SELL_HOUSE, ACTION_AUCTION_BUILDING}
+	for round := 0; round < maxManageRounds; round++ {
+		applied := false
+		for _, a := range s.Manage(v) {
+			if slices.Contains(manageable, a.Kind) && apply(a) == nil {
+				applied = true
+				if a.Kind == ACTION_AUCTION_BUILDING {
+					return
+				}
+			}
+		}
+		if !applied {
+			return
+		}
+	}
+}
+
+// liquidate sells buildings and mortgages properties of the player, until they can pay their debts.
+// It is used when the strategy didn't raise enough money, though the player isn't bankrupt.
+func (d *Driver) liquidate(v *View, apply func(Action) error) {
+	for v.p.canPayDebts() != nil {
+		legal := v.LegalActions()
+		i := slices.IndexFunc(legal, func(a Action) bool { return a.Kind == ACTION_SELL_HOUSE })
+		if i == -1 {
+			i = slices.IndexFunc(legal, func(a Action) bool { return a.Kind == ACTION_MORTGAGE_PROPERTY })
+		}
+		if i == -1 || apply(legal[i]) != nil {
+			return
+		}
+	}
+}
+
+// RandomStrategy decides randomly. It is useful as a baseline for other strategies.
+type RandomStrategy struct {
+	Random RandomSource
+}
+
+// NewRandomStrategy returns a random strategy using a [SeededSource] with seed.
+func NewRandomStrategy(seed int64) *RandomStrategy {
+	return &RandomStrategy{Random: NewSeededSource(seed)}
+}
+
+// Buy implements [Strategy] interface.
+func (s *RandomStrategy) Buy(v *View, prop Property) bool {
+	return s.Random.Intn(2) == 0
+}
+
+// Bid implements [Strategy] interface.
+func (s *RandomStrategy) Bid(v *View) int {
+	if s.Random.Intn(2) == 0 {
+		return 0
+	}
+	return v.MinimumBid()
+}
+
+// Manage implements [Strategy] interface.
+func (s *RandomStrategy) Manage(v *View) []Action {
+	if v.Debt(v.Self()) > 0 {
+		return raiseFunds(v)
+	}
+	if s.Random.Intn(4) != 0 {
+		return nil
+	}
+	var candidates []Action
+	for _, a := range v.LegalActions() {
+		switch a.Kind {
+		case ACTION_MORTGAGE_PROPERTY, ACTION_CANCEL_MORTGAGE_PROPERTY, ACTION_BUY_HOUSE, ACTION_SELL_HOUSE:
+			candidates = append(candidates, a)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	return candidates[s.Random.Intn(len(candidates)):][:1]
+}
+
+// AcceptTrade implements [Strategy] interface.
+func (s *RandomStrategy) AcceptTrade(v *View, from Token, t Trade) bool {
+	return s.Random.Intn(2) == 0
+}
+
+// Jail implements [Strategy] interface.
+func (s *RandomStrategy) Jail(v *View) ActionKind {
+	return []ActionKind{ACTION_ROLL_DICE, ACTION_PAY_JAIL_FINE, ACTION_USE_JAIL_CARD}[s.Random.Intn(3)]
+}
+
+// GreedyStrategy buys every property it can afford and builds as soon as possible. During a housing
+// shortage it auctions the buildings it needs.
+type GreedyStrategy struct{}
+
+// Buy implements [Strategy] interface.
+func (GreedyStrategy) Buy(v *View, prop Property) bool {
+	return v.Money(v.Self()) >= v.Board().Price(prop)
+}
+
+// Bid implements [Strategy] interface. It bids up to the price of a property or building.
+func (GreedyStrategy) Bid(v *View) int {
+	return bidUpTo(v, auctionValue(v), 0)
+}
+
+// Manage implements [Strategy] interface.
+func (GreedyStrategy) Manage(v *View) []Action {
+	if v.Debt(v.Self()) > 0 {
+		return raiseFunds(v)
+	}
+	actions := append(liftMortgages(v, 0), buildPlan(v, 0)...)
+	return append(actions, buildingAuctions(v, 1, 0)...)
+}
+
+// AcceptTrade implements [Strategy] interface. It accepts every trade it doesn't lose value on.
+func (GreedyStrategy) AcceptTrade(v *View, from Token, t Trade) bool {
+	return tradeValue(v, t) >= 0
+}
+
+// Jail implements [Strategy] interface. It leaves jail as fast as possible to keep buying.
+func (GreedyStrategy) Jail(v *View) ActionKind {
+	if len(v.JailCards(v.Self())) != 0 {
+		return ACTION_USE_JAIL_CARD
+	}
+	return ACTION_PAY_JAIL_FINE
+}
+
+// ConservativeStrategy keeps a cash reserve for paying rent and only spends money above it. During a
+// housing shortage it auctions the buildings it needs, as long as it could bid above the reserve.
+type ConservativeStrategy struct {
+	// Reserve is the amount of money the strategy never spends on properties or buildings.
+	Reserve int
+}
+
+// Buy implements [Strategy] interface.
+func (s ConservativeStrategy) Buy(v *View, prop Property) bool {
+	return v.Money(v.Self())-v.Board().Price(prop) >= s.Reserve
+}
+
+// Bid implements [Strategy] interface. It bids up to half of the price of a property or building.
+func (s ConservativeStrategy) Bid(v *View) int {
+	return bidUpTo(v, auctionValue(v)/2, s.Reserve)
+}
+
+// Manage implements [Strategy] interface.
+func (s ConservativeStrategy) Manage(v *View) []Action {
+	if v.Debt(v.Self()) > 0 {
+		return raiseFunds(v)
+	}
+	actions := append(liftMortgages(v, 2*s.Reserve), buildPlan(v, 2*s.Reserve)...)
+	return append(actions, buildingAuctions(v, 2, s.Reserve)...)
+}
+
+// AcceptTrade implements [Strategy] interface. It only accepts trades that win value without
+// paying money.
+func (s ConservativeStrategy) AcceptTrade(v *View, from Token, t Trade) bool {
+	return t.Money >= 0 && tradeValue(v, t) > 0
+}
+
+// Jail implements [Strategy] interface. It stays in jail as long as possible, where it can still
+// collect rent without risking to pay any.
+func (s ConservativeStrategy) Jail(v *View) ActionKind {
+	return ACTION_ROLL_DICE
+}
+
+// auctionValue returns the price of the auctioned property, or the value of the auctioned building.
+func auctionValue(v *View) int {
+	if prop, ok := v.AuctionedProperty(); ok {
+		return v.Board().Price(prop)
+	}
+	b, ok := v.AuctionedBuilding()
+	if !ok {
+		return 0
+	}
+	return buildingValue(v, b)
+}
+
+// buildingValue returns the highest cost of a building of kind b on any street the player could
+// build it on.
+func buildingValue(v *View, b Building) int {
+	var value int
+	for prop, state := range v.p.inventory {
+		if nextBuilding(state) == b && v.p.canBuildHouse(prop) == nil {
+			value = max(value, v.Board().HouseCost(prop))
+		}
+	}
+	return value
+}
+
+// buildingAuctions returns the building auctions to start during a housing shortage. An auction is
+// only started if the player would bid in it: the first bid must not exceed the value of the
+// building divided by divisor and must keep reserve money left.
+func buildingAuctions(v *View, divisor, reserve int) []Action {
+	var actions []Action
+	for _, b := range []Building{HOUSE, HOTEL} {
+		firstBid := v.Rules().AuctionMinIncrement
+		if v.g.housingShortage(b) && firstBid <= buildingValue(v, b)/divisor && v.Money(v.Self())-firstBid >= reserve {
+			actions = append(actions, Action{Kind: ACTION_AUCTION_BUILDING, Building: b})
+		}
+	}
+	return actions
+}
+
+// bidUpTo returns the minimum bid of the running auction if it doesn't exceed limit and keeps
+// reserve money left, otherwise 0 to pass.
+func bidUpTo(v *View, limit, reserve int) int {
+	bid := v.MinimumBid()
+	if bid > limit || v.Money(v.Self())-bid < reserve {
+		return 0
+	}
+	return bid
+}
+
+// raiseFunds returns the houses to sell and properties to mortgage to pay the debts of the player.
+func raiseFunds(v *View) []Action {
+	need := v.Debt(v.Self()) - v.Money(v.Self())
+	inventory := v.Inventory(v.Self())
+	var actions []Action
+	for _, prop := range ownedProperties(v, inventory) {
+		for state := inventory[prop]; state > STATE_NORMAL && need > 0; state-- {
+			actions = append(actions, Action{Kind: ACTION_SELL_HOUSE, Property: prop})
+			need -= v.Board().HouseCost(prop) / 2
+		}
+	}
+	for _, prop := range ownedProperties(v, inventory) {
+		if need > 0 && inventory[prop] == STATE_NORMAL {
+			actions = append(actions, Action{Kind: ACTION_MORTGAGE_PROPERTY, Property: prop})
+			need -= v.Board().MortgageValue(prop)
+		}
+	}
+	return actions
+}
+
+// liftMortgages returns the mortgages the player can lift while keeping reserve money left.
+func liftMortgages(v *View, reserve int) []Action {
+	money := v.Money(v.Self())
+	inventory := v.Inventory(v.Self())
+	var actions []Action
+	for _, prop := range ownedProperties(v, inventory) {
+		cost := v.g.cancelMortgageCost(prop)
+		if inventory[prop] == STATE_MORTGAGE && money-cost >= reserve {
+			actions = append(actions, Action{Kind: ACTION_CANCEL_MORTGAGE_PROPERTY, Property: prop})
+			money -= cost
+		}
+	}
+	return actions
+}
+
+// buildPlan returns the houses the player can buy on their color groups while keeping reserve
+// money left.
+func buildPlan(v *View, reserve int) []Action {
+	money := v.Money(v.Self())
+	inventory := v.Inventory(v.Self())
+	var actions []Action
+	for _, prop := range ownedProperties(v, inventory) {
+		cost := v.Board().HouseCost(prop)
+		if _, ok := v.Board().ColorGroup(prop); ok && inventory[prop] < STATE_HOTEL && money-cost >= reserve {
+			actions = append(actions, Action{Kind: ACTION_BUY_HOUSE, Property: prop})
+			money -= cost
+		}
+	}
+	return actions
+}
+
+// tradeValue returns how much value the player wins with the trade t proposed to them, counting
+// properties with their price and Get Out of Jail Free cards with the jail fine.
+func tradeValue(v *View, t Trade) int {
+	value := t.Money
+	for _, prop := range t.Offer {
+		value += v.Board().Price(prop)
+	}
+	for _, prop := range t.Request {
+		value -= v.Board().Price(prop)
+	}
+	return value + v.Rules().JailFine*(len(t.OfferCards)-len(t.RequestCards))
+}
+
+// ownedProperties returns the properties of inventory in the order of the board.
+func ownedProperties(v *View, inventory Inventory) []Property {
+	var props []Property
+	for f := GO; int(f) < numberOfFields; f++ {
+		if prop, ok := v.Board().Property(f); ok {
+			if _, hasProp := inventory[prop]; hasProp {
+				props = append(props, prop)
+			}
+		}
+	}
+	return props
+}
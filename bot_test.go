@@ -0,0 +1,91 @@
+package monopoly
+
+import "testing"
+
+func TestDriver_Run(t *testing.T) {
+	for seed := int64(1); seed <= 5; seed++ {
+		rules := DefaultRules()
+		rules.Random = NewSeededSource(seed)
+		g := NewGame(rules, CAR, DOG, HAT, SHIP)
+		d := NewDriver(g, map[Token]Strategy{
+			CAR:  GreedyStrategy{},
+			DOG:  ConservativeStrategy{Reserve: 200},
+			HAT:  NewRandomStrategy(seed),
+			SHIP: NewRandomStrategy(seed + 1),
+		})
+		steps, err := d.Run(5000)
+		if err != nil {
+			t.Fatalf("seed %d: Driver.Run() after %d steps got error: %v", seed, steps, err)
+		}
+		if _, state := g.GetCurrentPlayer(); steps < 5000 && state != GAME_OVER {
+			t.Errorf("seed %d: bots stopped after %d steps in state %#v", seed, steps, state)
+		}
+	}
+}
+
+func TestDriver_Step_human(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG)
+	human, _ := g.GetCurrentPlayer()
+	bot := g.players[1-g.currentTurn]
+	d := NewDriver(g, map[Token]Strategy{bot.token: GreedyStrategy{}})
+
+	if acted, err := d.Step(); acted || err != nil {
+		t.Fatalf("Driver.Step() on the turn of a human got %t, %v, want false", acted, err)
+	}
+	g.state = GAME_TURN
+	bot.inventory[BOARDWALK] = STATE_NORMAL
+	human.ProposeTrade(bot, Trade{Request: []Property{BOARDWALK}, Money: BOARDWALK.GetBaseCost() + 100})
+	if acted, err := d.Step(); !acted || err != nil {
+		t.Fatalf("Driver.Step() on a trade proposed to a bot got %t, %v, want true", acted, err)
+	}
+	if _, ok := human.inventory[BOARDWALK]; !ok {
+		t.Error("greedy bot didn't accept a profitable trade")
+	}
+}
+
+func TestDriver_Step_housingShortage(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG)
+	p, _ := g.GetCurrentPlayer()
+	other := g.players[1-g.currentTurn]
+	p.inventory[PARK_PLACE] = STATE_NORMAL
+	p.inventory[BOARDWALK] = STATE_NORMAL
+	other.inventory[MEDITERRANEAN_AVENUE] = STATE_NORMAL
+	other.inventory[BALTIC_AVENUE] = STATE_NORMAL
+	g.houses = 1
+	g.state = GAME_TURN
+	d := NewDriver(g, map[Token]Strategy{p.token: GreedyStrategy{}, other.token: ConservativeStrategy{Reserve: 200}})
+
+	if _, err := d.Step(); err != nil {
+		t.Fatalf("Driver.Step() got error: %v", err)
+	}
+	if a, ok := g.Auction(); !ok {
+		t.Fatalf("greedy bot didn't auction a building during a housing shortage, state %#v", g.state)
+	} else if b, ok := a.Building(); !ok || b != HOUSE {
+		t.Fatalf("greedy bot auctioned %#v, want a house", a)
+	}
+	for i := 0; i < 10 && g.state == GAME_AUCTION; i++ {
+		if _, err := d.Step(); err != nil {
+			t.Fatalf("Driver.Step() during the auction got error: %v", err)
+		}
+	}
+	if g.houses != 0 || p.reserved[HOUSE]+other.reserved[HOUSE] != 1 {
+		t.Errorf("after the auction: houses = %d, reserved = %d and %d", g.houses, p.reserved[HOUSE], other.reserved[HOUSE])
+	}
+}
+
+func TestStrategy_Buy(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG)
+	p, _ := g.GetCurrentPlayer()
+	v := &View{g: g, p: p}
+	p.money = 450
+
+	if !(GreedyStrategy{}).Buy(v, BOARDWALK) {
+		t.Error("GreedyStrategy.Buy() with enough money got false")
+	}
+	if (ConservativeStrategy{Reserve: 100}).Buy(v, BOARDWALK) {
+		t.Error("ConservativeStrategy.Buy() below the reserve got true")
+	}
+	if !(ConservativeStrategy{Reserve: 100}).Buy(v, MEDITERRANEAN_AVENUE) {
+		t.Error("ConservativeStrategy.Buy() above the reserve got false")
+	}
+}
@@ -0,0 +1,173 @@
+package monopoly
+
+import (
+	"github.com/Kesuaheli/monopoly/lang"
+	"golang.org/x/text/language"
+)
+
+// Building is a kind of building the bank supplies for building on streets.
+type Building int8
+
+const (
+	HOUSE Building = iota
+	HOTEL
+)
+
+// String returns the english name for b.
+// String implements [fmt.Stringer] interface.
+func (b Building) String() string {
+	return b.Localize(language.English)
+}
+
+// Localize returns the localized name for b in the language langTag.
+func (b Building) Localize(langTag language.Tag) string {
+	switch b {
+	case HOUSE:
+		return lang.MustLocalize("monopoly.building.house", langTag)
+	case HOTEL:
+		return lang.MustLocalize("monopoly.building.hotel", langTag)
+	default:
+		return lang.MustLocalize("unknown", langTag)
+	}
+}
+
+// GoString implements [fmt.GoStringer] interface.
+func (b Building) GoString() string {
+	switch b {
+	case HOUSE:
+		return "HOUSE"
+	case HOTEL:
+		return "HOTEL"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// nextBuilding returns the kind of building that is needed to improve a street in the state ps.
+func nextBuilding(ps PropertyState) Building {
+	if ps == STATE_HOUSE_4 {
+		return HOTEL
+	}
+	return HOUSE
+}
+
+// Houses returns the number of houses the bank has left.
+func (g *Game) Houses() int {
+	g.lock()
+	defer g.unlock()
+	return g.houses
+}
+
+// Hotels returns the number of hotels the bank has left.
+func (g *Game) Hotels() int {
+	g.lock()
+	defer g.unlock()
+	return g.hotels
+}
+
+// supply returns a pointer to the number of buildings of kind b the bank has left.
+func (g *Game) supply(b Building) *int {
+	if b == HOTEL {
+		return &g.hotels
+	}
+	return &g.houses
+}
+
+// returnBuildings puts all buildings of a street in the state ps back into the bank.
+func (g *Game) returnBuildings(ps PropertyState) {
+	if ps == STATE_HOTEL {
+		g.hotels++
+	} else if ps > STATE_NORMAL {
+		g.houses += int(ps)
+	}
+}
+
+// wantsBuilding reports whether p could currently buy a building of kind b for any of their streets.
+func (p *Player) wantsBuilding(b Building) bool {
+	if p.bankrupt {
+		return false
+	}
+	for prop, state := range p.inventory {
+		if nextBuilding(state) == b && p.canBuildHouse(prop) == nil && p.money >= p.game.board.HouseCost(prop) {
+			return true
+		}
+	}
+	return false
+}
+
+// HousingShortage reports whether the bank has not enough buildings of kind b left for every
+// player who wants to buy one. In this case the buildings have to be auctioned.
+func (g *Game) HousingShortage(b Building) bool {
+	g.lock()
+	defer g.unlock()
+	return g.housingShortage(b)
+}
+
+// housingShortage is like HousingShortage, but expects g to be locked.
+func (g *Game) housingShortage(b Building) bool {
+	var wanting int
+	for _, p := range g.players {
+		if p.wantsBuilding(b) {
+			wanting++
+		}
+	}
+	return wanting > 1 && wanting > *g.supply(b)
+}
+
+// ReservedBuildings returns the number of buildings of kind b p won in auctions, but didn't build
+// yet.
+func (p *Player) ReservedBuildings(b Building) int {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.reserved[b]
+}
+
+// AuctionBuilding starts an auction for a building of kind b during a housing shortage. Every player
+// who wants to buy such a building may bid. The winner pays their bid and can build the building
+// afterwards without paying for it again.
+func (p *Player) AuctionBuilding(b Building) error {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.auctionBuilding(b)
+}
+
+// auctionBuilding is like AuctionBuilding, but expects the game to be locked.
+func (p *Player) auctionBuilding(b Building) error {
+	if err := p.canAuctionBuilding(b); err != nil {
+		return err
+	}
+	p.game.record(Action{Kind: ACTION_AUCTION_BUILDING, Player: p.token, Building: b})
+
+	a := &Auction{
+		property:     -1,
+		building:     b,
+		resume:       p.game.state,
+		minIncrement: p.game.rules.AuctionMinIncrement,
+		passed:       map[*Player]bool{},
+	}
+	for _, other := range p.game.players {
+		if !other.wantsBuilding(b) {
+			a.passed[other] = true
+		}
+	}
+	p.game.auction = a
+	p.game.state = GAME_AUCTION
+	return nil
+}
+
+// canAuctionBuilding returns an error why p can't start an auction for a building of kind b.
+func (p *Player) canAuctionBuilding(b Building) error {
+	if err := p.game.checkAction(ACTION_AUCTION_BUILDING); err != nil {
+		return err
+	}
+	if !p.game.housingShortage(b) {
+		return ErrNoHousingShortage
+	}
+	if *p.game.supply(b) == 0 {
+		return ErrNoBuildingsLeft
+	}
+	if !p.wantsBuilding(b) {
+		return ErrCantBuild
+	}
+	return nil
+}
@@ -0,0 +1,441 @@
+package monopoly
+
+import (
+	"github.com/Kesuaheli/monopoly/lang"
+	"golang.org/x/text/language"
+)
+
+// Card is a single card of either the Chance or the Community Chest deck.
+type Card int8
+
+const (
+	CHANCE_ADVANCE_TO_BOARDWALK Card = iota
+	CHANCE_ADVANCE_TO_GO
+	CHANCE_ADVANCE_TO_ILLINOIS_AVENUE
+	CHANCE_ADVANCE_TO_ST_CHARLES_PLACE
+	CHANCE_ADVANCE_TO_NEAREST_RAILROAD_1
+	CHANCE_ADVANCE_TO_NEAREST_RAILROAD_2
+	CHANCE_ADVANCE_TO_NEAREST_UTILITY
+	CHANCE_BANK_DIVIDEND
+	CHANCE_GET_OUT_OF_JAIL_FREE
+	CHANCE_GO_BACK_3_SPACES
+	CHANCE_GO_TO_JAIL
+	CHANCE_GENERAL_REPAIRS
+	CHANCE_SPEEDING_FINE
+	CHANCE_TRIP_TO_READING_RAILROAD
+	CHANCE_CHAIRMAN_OF_THE_BOARD
+	CHANCE_BUILDING_LOAN_MATURES
+
+	COMMUNITY_CHEST_ADVANCE_TO_GO
+	COMMUNITY_CHEST_BANK_ERROR
+	COMMUNITY_CHEST_DOCTORS_FEE
+	COMMUNITY_CHEST_SALE_OF_STOCK
+	COMMUNITY_CHEST_GET_OUT_OF_JAIL_FREE
+	COMMUNITY_CHEST_GO_TO_JAIL
+	COMMUNITY_CHEST_HOLIDAY_FUND
+	COMMUNITY_CHEST_INCOME_TAX_REFUND
+	COMMUNITY_CHEST_BIRTHDAY
+	COMMUNITY_CHEST_LIFE_INSURANCE
+	COMMUNITY_CHEST_HOSPITAL_FEES
+	COMMUNITY_CHEST_SCHOOL_FEES
+	COMMUNITY_CHEST_CONSULTANCY_FEE
+	COMMUNITY_CHEST_STREET_REPAIRS
+	COMMUNITY_CHEST_BEAUTY_CONTEST
+	COMMUNITY_CHEST_INHERITANCE
+)
+
+// allChanceCards returns a slice of all cards in a complete Chance deck.
+func allChanceCards() []Card {
+	return []Card{
+		CHANCE_ADVANCE_TO_BOARDWALK,
+		CHANCE_ADVANCE_TO_GO,
+		CHANCE_ADVANCE_TO_ILLINOIS_AVENUE,
+		CHANCE_ADVANCE_TO_ST_CHARLES_PLACE,
+		CHANCE_ADVANCE_TO_NEAREST_RAILROAD_1,
+		CHANCE_ADVANCE_TO_NEAREST_RAILROAD_2,
+		CHANCE_ADVANCE_TO_NEAREST_UTILITY,
+		CHANCE_BANK_DIVIDEND,
+		CHANCE_GET_OUT_OF_JAIL_FREE,
+		CHANCE_GO_BACK_3_SPACES,
+		CHANCE_GO_TO_JAIL,
+		CHANCE_GENERAL_REPAIRS,
+		CHANCE_SPEEDING_FINE,
+		CHANCE_TRIP_TO_READING_RAILROAD,
+		CHANCE_CHAIRMAN_OF_THE_BOARD,
+		CHANCE_BUILDING_LOAN_MATURES,
+	}
+}
+
+// allCommunityChestCards returns a slice of all cards in a complete Community Chest deck.
+func allCommunityChestCards() []Card {
+	return []Card{
+		COMMUNITY_CHEST_ADVANCE_TO_GO,
+		COMMUNITY_CHEST_BANK_ERROR,
+		COMMUNITY_CHEST_DOCTORS_FEE,
+		COMMUNITY_CHEST_SALE_OF_STOCK,
+		COMMUNITY_CHEST_GET_OUT_OF_JAIL_FREE,
+		COMMUNITY_CHEST_GO_TO_JAIL,
+		COMMUNITY_CHEST_HOLIDAY_FUND,
+		COMMUNITY_CHEST_INCOME_TAX_REFUND,
+		COMMUNITY_CHEST_BIRTHDAY,
+		COMMUNITY_CHEST_LIFE_INSURANCE,
+		COMMUNITY_CHEST_HOSPITAL_FEES,
+		COMMUNITY_CHEST_SCHOOL_FEES,
+		COMMUNITY_CHEST_CONSULTANCY_FEE,
+		COMMUNITY_CHEST_STREET_REPAIRS,
+		COMMUNITY_CHEST_BEAUTY_CONTEST,
+		COMMUNITY_CHEST_INHERITANCE,
+	}
+}
+
+// IsChance reports whether c belongs to the Chance deck.
+func (c Card) IsChance() bool {
+	return c >= CHANCE_ADVANCE_TO_BOARDWALK && c <= CHANCE_BUILDING_LOAN_MATURES
+}
+
+// IsCommunityChest reports whether c belongs to the Community Chest deck.
+func (c Card) IsCommunityChest() bool {
+	return c >= COMMUNITY_CHEST_ADVANCE_TO_GO && c <= COMMUNITY_CHEST_INHERITANCE
+}
+
+// IsGetOutOfJailFree reports whether c is a Get Out of Jail Free card, which a player keeps until
+// it is used.
+func (c Card) IsGetOutOfJailFree() bool {
+	return c == CHANCE_GET_OUT_OF_JAIL_FREE || c == COMMUNITY_CHEST_GET_OUT_OF_JAIL_FREE
+}
+
+// String returns the english text of c.
+// String implements [fmt.Stringer] interface.
+func (c Card) String() string {
+	return c.Localize(language.English)
+}
+
+// Localize returns the localized text of c in the language langTag.
+func (c Card) Localize(langTag language.Tag) string {
+	switch c {
+	case CHANCE_ADVANCE_TO_BOARDWALK:
+		return lang.MustLocalize("monopoly.card.chance.advance_to_boardwalk", langTag)
+	case CHANCE_ADVANCE_TO_GO:
+		return lang.MustLocalize("monopoly.card.chance.advance_to_go", langTag)
+	case CHANCE_ADVANCE_TO_ILLINOIS_AVENUE:
+		return lang.MustLocalize("monopoly.card.chance.advance_to_illinois_avenue", langTag)
+	case CHANCE_ADVANCE_TO_ST_CHARLES_PLACE:
+		return lang.MustLocalize("monopoly.card.chance.advance_to_st_charles_place", langTag)
+	case CHANCE_ADVANCE_TO_NEAREST_RAILROAD_1, CHANCE_ADVANCE_TO_NEAREST_RAILROAD_2:
+		return lang.MustLocalize("monopoly.card.chance.advance_to_nearest_railroad", langTag)
+	case CHANCE_ADVANCE_TO_NEAREST_UTILITY:
+		return lang.MustLocalize("monopoly.card.chance.advance_to_nearest_utility", langTag)
+	case CHANCE_GET_OUT_OF_JAIL_FREE:
+		return lang.MustLocalize("monopoly.card.chance.get_out_of_jail_free", langTag)
+	case CHANCE_BANK_DIVIDEND:
+		return lang.MustLocalize("monopoly.card.chance.bank_dividend", langTag)
+	case CHANCE_GO_BACK_3_SPACES:
+		return lang.MustLocalize("monopoly.card.chance.go_back_3_spaces", langTag)
+	case CHANCE_GO_TO_JAIL:
+		return lang.MustLocalize("monopoly.card.chance.go_to_jail", langTag)
+	case CHANCE_GENERAL_REPAIRS:
+		return lang.MustLocalize("monopoly.card.chance.general_repairs", langTag)
+	case CHANCE_SPEEDING_FINE:
+		return lang.MustLocalize("monopoly.card.chance.speeding_fine", langTag)
+	case CHANCE_TRIP_TO_READING_RAILROAD:
+		return lang.MustLocalize("monopoly.card.chance.trip_to_reading_railroad", langTag)
+	case CHANCE_CHAIRMAN_OF_THE_BOARD:
+		return lang.MustLocalize("monopoly.card.chance.chairman_of_the_board", langTag)
+	case CHANCE_BUILDING_LOAN_MATURES:
+		return lang.MustLocalize("monopoly.card.chance.building_loan_matures", langTag)
+	case COMMUNITY_CHEST_ADVANCE_TO_GO:
+		return lang.MustLocalize("monopoly.card.community_chest.advance_to_go", langTag)
+	case COMMUNITY_CHEST_BANK_ERROR:
+		return lang.MustLocalize("monopoly.card.community_chest.bank_error", langTag)
+	case COMMUNITY_CHEST_DOCTORS_FEE:
+		return lang.MustLocalize("monopoly.card.community_chest.doctors_fee", langTag)
+	case COMMUNITY_CHEST_GET_OUT_OF_JAIL_FREE:
+		return lang.MustLocalize("monopoly.card.community_chest.get_out_of_jail_free", langTag)
+	case COMMUNITY_CHEST_SALE_OF_STOCK:
+		return lang.MustLocalize("monopoly.card.community_chest.sale_of_stock", langTag)
+	case COMMUNITY_CHEST_GO_TO_JAIL:
+		return lang.MustLocalize("monopoly.card.community_chest.go_to_jail", langTag)
+	case COMMUNITY_CHEST_HOLIDAY_FUND:
+		return lang.MustLocalize("monopoly.card.community_chest.holiday_fund", langTag)
+	case COMMUNITY_CHEST_INCOME_TAX_REFUND:
+		return lang.MustLocalize("monopoly.card.community_chest.income_tax_refund", langTag)
+	case COMMUNITY_CHEST_BIRTHDAY:
+		return lang.MustLocalize("monopoly.card.community_chest.birthday", langTag)
+	case COMMUNITY_CHEST_LIFE_INSURANCE:
+		return lang.MustLocalize("monopoly.card.community_chest.life_insurance", langTag)
+	case COMMUNITY_CHEST_HOSPITAL_FEES:
+		return lang.MustLocalize("monopoly.card.community_chest.hospital_fees", langTag)
+	case COMMUNITY_CHEST_SCHOOL_FEES:
+		return lang.MustLocalize("monopoly.card.community_chest.school_fees", langTag)
+	case COMMUNITY_CHEST_CONSULTANCY_FEE:
+		return lang.MustLocalize("monopoly.card.community_chest.consultancy_fee", langTag)
+	case COMMUNITY_CHEST_STREET_REPAIRS:
+		return lang.MustLocalize("monopoly.card.community_chest.street_repairs", langTag)
+	case COMMUNITY_CHEST_BEAUTY_CONTEST:
+		return lang.MustLocalize("monopoly.card.community_chest.beauty_contest", langTag)
+	case COMMUNITY_CHEST_INHERITANCE:
+		return lang.MustLocalize("monopoly.card.community_chest.inheritance", langTag)
+	default:
+		return lang.MustLocalize("unknown", langTag)
+	}
+}
+
+// GoString implements [fmt.GoStringer] interface.
+func (c Card) GoString() string {
+	switch c {
+	case CHANCE_ADVANCE_TO_BOARDWALK:
+		return "CHANCE_ADVANCE_TO_BOARDWALK"
+	case CHANCE_ADVANCE_TO_GO:
+		return "CHANCE_ADVANCE_TO_GO"
+	case CHANCE_ADVANCE_TO_ILLINOIS_AVENUE:
+		return "CHANCE_ADVANCE_TO_ILLINOIS_AVENUE"
+	case CHANCE_ADVANCE_TO_ST_CHARLES_PLACE:
+		return "CHANCE_ADVANCE_TO_ST_CHARLES_PLACE"
+	case CHANCE_ADVANCE_TO_NEAREST_RAILROAD_1:
+		return "CHANCE_ADVANCE_TO_NEAREST_RAILROAD_1"
+	case CHANCE_ADVANCE_TO_NEAREST_RAILROAD_2:
+		return "CHANCE_ADVANCE_TO_NEAREST_RAILROAD_2"
+	case CHANCE_ADVANCE_TO_NEAREST_UTILITY:
+		return "CHANCE_ADVANCE_TO_NEAREST_UTILITY"
+	case CHANCE_GET_OUT_OF_JAIL_FREE:
+		return "CHANCE_GET_OUT_OF_JAIL_FREE"
+	case CHANCE_BANK_DIVIDEND:
+		return "CHANCE_BANK_DIVIDEND"
+	case CHANCE_GO_BACK_3_SPACES:
+		return "CHANCE_GO_BACK_3_SPACES"
+	case CHANCE_GO_TO_JAIL:
+		return "CHANCE_GO_TO_JAIL"
+	case CHANCE_GENERAL_REPAIRS:
+		return "CHANCE_GENERAL_REPAIRS"
+	case CHANCE_SPEEDING_FINE:
+		return "CHANCE_SPEEDING_FINE"
+	case CHANCE_TRIP_TO_READING_RAILROAD:
+		return "CHANCE_TRIP_TO_READING_RAILROAD"
+	case CHANCE_CHAIRMAN_OF_THE_BOARD:
+		return "CHANCE_CHAIRMAN_OF_THE_BOARD"
+	case CHANCE_BUILDING_LOAN_MATURES:
+		return "CHANCE_BUILDING_LOAN_MATURES"
+	case COMMUNITY_CHEST_ADVANCE_TO_GO:
+		return "COMMUNITY_CHEST_ADVANCE_TO_GO"
+	case COMMUNITY_CHEST_BANK_ERROR:
+		return "COMMUNITY_CHEST_BANK_ERROR"
+	case COMMUNITY_CHEST_DOCTORS_FEE:
+		return "COMMUNITY_CHEST_DOCTORS_FEE"
+	case COMMUNITY_CHEST_GET_OUT_OF_JAIL_FREE:
+		return "COMMUNITY_CHEST_GET_OUT_OF_JAIL_FREE"
+	case COMMUNITY_CHEST_SALE_OF_STOCK:
+		return "COMMUNITY_CHEST_SALE_OF_STOCK"
+	case COMMUNITY_CHEST_GO_TO_JAIL:
+		return "COMMUNITY_CHEST_GO_TO_JAIL"
+	case COMMUNITY_CHEST_HOLIDAY_FUND:
+		return "COMMUNITY_CHEST_HOLIDAY_FUND"
+	case COMMUNITY_CHEST_INCOME_TAX_REFUND:
+		return "COMMUNITY_CHEST_INCOME_TAX_REFUND"
+	case COMMUNITY_CHEST_BIRTHDAY:
+		return "COMMUNITY_CHEST_BIRTHDAY"
+	case COMMUNITY_CHEST_LIFE_INSURANCE:
+		return "COMMUNITY_CHEST_LIFE_INSURANCE"
+	case COMMUNITY_CHEST_HOSPITAL_FEES:
+		return "COMMUNITY_CHEST_HOSPITAL_FEES"
+	case COMMUNITY_CHEST_SCHOOL_FEES:
+		return "COMMUNITY_CHEST_SCHOOL_FEES"
+	case COMMUNITY_CHEST_CONSULTANCY_FEE:
+		return "COMMUNITY_CHEST_CONSULTANCY_FEE"
+	case COMMUNITY_CHEST_STREET_REPAIRS:
+		return "COMMUNITY_CHEST_STREET_REPAIRS"
+	case COMMUNITY_CHEST_BEAUTY_CONTEST:
+		return "COMMUNITY_CHEST_BEAUTY_CONTEST"
+	case COMMUNITY_CHEST_INHERITANCE:
+		return "COMMUNITY_CHEST_INHERITANCE"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// Deck is a stack of cards. Cards are drawn from the top and put back at the bottom.
+type Deck []Card
+
+// newDeck returns a deck containing the given cards shuffled by r.
+func newDeck(cards []Card, r RandomSource) Deck {
+	d := make(Deck, len(cards))
+	copy(d, cards)
+	shuffle(r, len(d), func(i, j int) {
+		d[i], d[j] = d[j], d[i]
+	})
+	return d
+}
+
+// draw removes the top card from the deck and returns it.
+func (d *Deck) draw() Card {
+	c := (*d)[0]
+	*d = (*d)[1:]
+	return c
+}
+
+// putBack puts c back at the bottom of the deck.
+func (d *Deck) putBack(c Card) {
+	*d = append(*d, c)
+}
+
+// drawCard draws the top card from deck and puts it back at the bottom of the deck. Its effect is
+// applied by [Player.resolveCard] once p continues. A Get Out of Jail Free card is kept by p
+// instead.
+func (p *Player) drawCard(deck *Deck) {
+	c := deck.draw()
+	p.game.drawnCards = append(p.game.drawnCards, c)
+	p.game.emit(CardDrawnEvent{Player: p, Card: c})
+	if c.IsGetOutOfJailFree() {
+		p.game.jailCards[c] = p
+		return
+	}
+	deck.putBack(c)
+	p.game.state = GAME_CARD
+}
+
+// resolveCard applies the effect of the card p drew last.
+func (p *Player) resolveCard() {
+	p.game.state = GAME_MOVED_TO_NEW_FIELD
+	p.applyCard(p.game.drawnCards[len(p.game.drawnCards)-1])
+}
+
+// deck returns the deck c belongs to.
+func (g *Game) deck(c Card) *Deck {
+	if c.IsChance() {
+		return &g.chance
+	}
+	return &g.communityChest
+}
+
+// applyCard applies the effect of c to p.
+func (p *Player) applyCard(c Card) {
+	switch c {
+	case CHANCE_ADVANCE_TO_BOARDWALK, CHANCE_ADVANCE_TO_ILLINOIS_AVENUE, CHANCE_ADVANCE_TO_ST_CHARLES_PLACE, CHANCE_TRIP_TO_READING_RAILROAD:
+		if f, ok := p.game.cardTarget(c); ok {
+			p.advanceTo(f)
+			p.land()
+		}
+	case CHANCE_ADVANCE_TO_GO, COMMUNITY_CHEST_ADVANCE_TO_GO:
+		p.advanceTo(GO)
+	case CHANCE_ADVANCE_TO_NEAREST_RAILROAD_1, CHANCE_ADVANCE_TO_NEAREST_RAILROAD_2:
+		p.advanceTo(p.nearest(KIND_RAILROAD))
+		prop, _ := p.game.board.Property(p.position)
+		if owner, state, ok := p.game.playerForProperty(prop); ok && owner != p {
+			p.payRent(owner, prop, 2*rentFor(owner, prop, state, 0, 0))
+		}
+	case CHANCE_ADVANCE_TO_NEAREST_UTILITY:
+		p.advanceTo(p.nearest(KIND_UTILITY))
+		prop, _ := p.game.board.Property(p.position)
+		if owner, state, ok := p.game.playerForProperty(prop); ok && owner != p && state != STATE_MORTGAGE {
+			d1, d2 := RollDice(p.game.rng())
+			p.payRent(owner, prop, rentFor(owner, prop, state, d1+d2, 10))
+		}
+	case CHANCE_BANK_DIVIDEND:
+		p.money += 50
+	case CHANCE_GO_BACK_3_SPACES:
+		from := p.position
+		p.position = Field((int(p.position) - 3 + numberOfFields) % numberOfFields)
+		p.game.emit(MovedEvent{Player: p, From: from, To: p.position})
+		p.land()
+	case CHANCE_GO_TO_JAIL, COMMUNITY_CHEST_GO_TO_JAIL:
+		p.goToJail()
+	case CHANCE_GENERAL_REPAIRS:
+		p.payFee(p.repairCost(25, 100))
+	case CHANCE_SPEEDING_FINE:
+		p.payFee(15)
+	case CHANCE_CHAIRMAN_OF_THE_BOARD:
+		for _, other := range p.game.players {
+			if other == p || other.bankrupt {
+				continue
+			}
+			p.pay(50, other)
+		}
+	case CHANCE_BUILDING_LOAN_MATURES:
+		p.money += 150
+
+	case COMMUNITY_CHEST_BANK_ERROR:
+		p.money += 200
+	case COMMUNITY_CHEST_DOCTORS_FEE, COMMUNITY_CHEST_SCHOOL_FEES:
+		p.payFee(50)
+	case COMMUNITY_CHEST_SALE_OF_STOCK:
+		p.money += 50
+	case COMMUNITY_CHEST_HOLIDAY_FUND, COMMUNITY_CHEST_LIFE_INSURANCE, COMMUNITY_CHEST_INHERITANCE:
+		p.money += 100
+	case COMMUNITY_CHEST_INCOME_TAX_REFUND:
+		p.money += 20
+	case COMMUNITY_CHEST_BIRTHDAY:
+		for _, other := range p.game.players {
+			if other == p || other.bankrupt {
+				continue
+			}
+			other.pay(10, p)
+		}
+	case COMMUNITY_CHEST_HOSPITAL_FEES:
+		p.payFee(100)
+	case COMMUNITY_CHEST_CONSULTANCY_FEE:
+		p.money += 25
+	case COMMUNITY_CHEST_STREET_REPAIRS:
+		p.payFee(p.repairCost(40, 115))
+	case COMMUNITY_CHEST_BEAUTY_CONTEST:
+		p.money += 10
+	}
+}
+
+// cardTarget returns the field the "advance to" card c moves to on the board of g. The field is
+// looked up by its name in the classic board. If the board has no field with that name, Boardwalk
+// falls back to the last street and Reading Railroad to the first railroad. Otherwise ok is false
+// and the card has no effect.
+func (g *Game) cardTarget(c Card) (f Field, ok bool) {
+	var classic Field
+	switch c {
+	case CHANCE_ADVANCE_TO_BOARDWALK:
+		classic = Field(BOARDWALK)
+	case CHANCE_ADVANCE_TO_ILLINOIS_AVENUE:
+		classic = Field(ILLINOIS_AVENUE)
+	case CHANCE_ADVANCE_TO_ST_CHARLES_PLACE:
+		classic = Field(ST_CHARLES_PLACE)
+	case CHANCE_TRIP_TO_READING_RAILROAD:
+		classic = Field(READING_RAILROAD)
+	default:
+		return -1, false
+	}
+	if f, ok := g.board.fieldByName(DefaultBoard().Field(classic).Name); ok {
+		return f, true
+	}
+
+	switch c {
+	case CHANCE_ADVANCE_TO_BOARDWALK:
+		if streets := g.board.fieldsOfKind(KIND_STREET); len(streets) != 0 {
+			return streets[len(streets)-1], true
+		}
+	case CHANCE_TRIP_TO_READING_RAILROAD:
+		if railroads := g.board.fieldsOfKind(KIND_RAILROAD); len(railroads) != 0 {
+			return railroads[0], true
+		}
+	}
+	return -1, false
+}
+
+// nearest returns the next field ahead of p's position which is of the kind k.
+func (p *Player) nearest(k FieldKind) Field {
+	for i := 1; i < numberOfFields; i++ {
+		f := Field((int(p.position) + i) % numberOfFields)
+		if p.game.board.Kind(f) == k {
+			return f
+		}
+	}
+	return p.position
+}
+
+// repairCost returns the amount p has to pay for repairs on all of their buildings, when every house
+// costs perHouse and every hotel costs perHotel.
+func (p *Player) repairCost(perHouse, perHotel int) int {
+	var cost int
+	for _, state := range p.inventory {
+		if state == STATE_HOTEL {
+			cost += perHotel
+		} else if state > STATE_NORMAL {
+			cost += int(state) * perHouse
+		}
+	}
+	return cost
+}
@@ -0,0 +1,191 @@
+package monopoly
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDeck_draw(t *testing.T) {
+	all := allChanceCards()
+	d := newDeck(all, NewSeededSource(1))
+	if len(d) != len(all) {
+		t.Fatalf("newDeck() got %d cards, want %d", len(d), len(all))
+	}
+
+	first := d[0]
+	for range all {
+		c := d.draw()
+		d.putBack(c)
+	}
+	if len(d) != len(all) {
+		t.Errorf("Deck has %d cards after drawing every card once, want %d", len(d), len(all))
+	}
+	if d[0] != first {
+		t.Errorf("Deck has %#v on top after drawing every card once, want %#v", d[0], first)
+	}
+}
+
+func TestPlayer_drawCard(t *testing.T) {
+	tests := []struct {
+		card      Card
+		from      Field
+		wantField Field
+		wantMoney int
+	}{
+		{CHANCE_ADVANCE_TO_GO, CHANCE_1, GO, defaultRules.StartMoney + defaultRules.MoneyOnGo},
+		{CHANCE_ADVANCE_TO_ILLINOIS_AVENUE, CHANCE_3, Field(ILLINOIS_AVENUE), defaultRules.StartMoney + defaultRules.MoneyOnGo},
+		{CHANCE_ADVANCE_TO_ST_CHARLES_PLACE, CHANCE_1, Field(ST_CHARLES_PLACE), defaultRules.StartMoney},
+		{CHANCE_ADVANCE_TO_NEAREST_RAILROAD_1, CHANCE_2, Field(BALTIMORE_OHIO_RAILROAD), defaultRules.StartMoney},
+		{CHANCE_ADVANCE_TO_NEAREST_UTILITY, CHANCE_3, Field(ELECTRIC_COMPANY), defaultRules.StartMoney + defaultRules.MoneyOnGo},
+		{CHANCE_GO_BACK_3_SPACES, CHANCE_1, INCOME_TAX, defaultRules.StartMoney - DefaultBoard().Tax(INCOME_TAX)},
+		{CHANCE_GO_TO_JAIL, CHANCE_2, IN_JAIL, defaultRules.StartMoney},
+		{CHANCE_SPEEDING_FINE, CHANCE_1, CHANCE_1, defaultRules.StartMoney - 15},
+		{CHANCE_CHAIRMAN_OF_THE_BOARD, CHANCE_1, CHANCE_1, defaultRules.StartMoney - 50},
+		{COMMUNITY_CHEST_BANK_ERROR, COMMUNITY_CHEST_1, COMMUNITY_CHEST_1, defaultRules.StartMoney + 200},
+		{COMMUNITY_CHEST_GO_TO_JAIL, COMMUNITY_CHEST_2, IN_JAIL, defaultRules.StartMoney},
+		{COMMUNITY_CHEST_BIRTHDAY, COMMUNITY_CHEST_3, COMMUNITY_CHEST_3, defaultRules.StartMoney + 10},
+	}
+	for _, tt := range tests {
+		g := NewGame(DefaultRules(), CAR, DOG)
+		p := g.players[0]
+		p.position = tt.from
+		g.chance = Deck{tt.card}
+
+		p.drawCard(&g.chance)
+		if g.state != GAME_CARD {
+			t.Errorf("%#v: game is in state %#v after drawing, want %#v", tt.card, g.state, GAME_CARD)
+		}
+		p.resolveCard()
+		if p.position != tt.wantField {
+			t.Errorf("%#v: player is on %#v, want %#v", tt.card, p.position, tt.wantField)
+		}
+		if p.money != tt.wantMoney {
+			t.Errorf("%#v: player has %d money, want %d", tt.card, p.money, tt.wantMoney)
+		}
+		if c, ok := g.LastCard(); !ok || c != tt.card {
+			t.Errorf("%#v: Game.LastCard() got = (%#v, %t)", tt.card, c, ok)
+		}
+	}
+}
+
+func TestPlayer_drawCard_bankruptPlayer(t *testing.T) {
+	for _, c := range []Card{CHANCE_CHAIRMAN_OF_THE_BOARD, COMMUNITY_CHEST_BIRTHDAY} {
+		g := NewGame(DefaultRules(), CAR, DOG, HAT)
+		p, _ := g.GetCurrentPlayer()
+		other := g.players[(g.currentTurn+1)%3]
+		bankrupt := g.players[(g.currentTurn+2)%3]
+		bankrupt.money = 0
+		bankrupt.bankrupt = true
+		g.state = GAME_ROLLED_DICE
+		g.chance = Deck{c}
+
+		p.drawCard(&g.chance)
+		p.resolveCard()
+		if bankrupt.money != 0 || len(bankrupt.debts) != 0 {
+			t.Errorf("%#v: bankrupt player has %d money and debts %v, want none", c, bankrupt.money, bankrupt.debts)
+		}
+		if want := defaultRules.StartMoney + 10; c == COMMUNITY_CHEST_BIRTHDAY && (p.money != want || other.money != defaultRules.StartMoney-10) {
+			t.Errorf("%#v: player has %d money, want %d", c, p.money, want)
+		}
+		if want := defaultRules.StartMoney - 50; c == CHANCE_CHAIRMAN_OF_THE_BOARD && (p.money != want || other.money != defaultRules.StartMoney+50) {
+			t.Errorf("%#v: player has %d money, want %d", c, p.money, want)
+		}
+		if g.state == GAME_DEBT {
+			t.Errorf("%#v: game is in state %#v", c, g.state)
+		}
+	}
+}
+
+func TestPlayer_drawCard_customBoard(t *testing.T) {
+	data := string(classicBoard)
+	park := "  - {kind: street, name: park_place, group: dark_blue, price: 350, rent: [35, 175, 500, 1100, 1300, 1500], house_cost: 200}\n"
+	boardwalk := "  - {kind: street, name: boardwalk, group: dark_blue, price: 400, rent: [50, 200, 600, 1400, 1700, 2000], house_cost: 200}\n"
+	data = strings.NewReplacer(park, boardwalk, boardwalk, park).Replace(data)
+	data = strings.Replace(data, "name: reading_railroad", "name: castle_railroad", 1)
+	board, err := ParseBoard([]byte(data))
+	if err != nil {
+		t.Fatalf("ParseBoard() got error: %v", err)
+	}
+
+	tests := []struct {
+		card      Card
+		wantField Field
+	}{
+		{CHANCE_ADVANCE_TO_BOARDWALK, Field(PARK_PLACE)},
+		{CHANCE_TRIP_TO_READING_RAILROAD, Field(READING_RAILROAD)},
+		{CHANCE_ADVANCE_TO_ILLINOIS_AVENUE, Field(ILLINOIS_AVENUE)},
+	}
+	for _, tt := range tests {
+		rules := DefaultRules()
+		rules.Board = board
+		g := NewGame(rules, CAR, DOG)
+		p := g.players[0]
+		p.position = CHANCE_1
+		g.chance = Deck{tt.card}
+
+		p.drawCard(&g.chance)
+		p.resolveCard()
+		if p.position != tt.wantField {
+			t.Errorf("%#v: player is on %#v, want %#v", tt.card, p.position, tt.wantField)
+		}
+	}
+
+	rules := DefaultRules()
+	rules.Board = board
+	g := NewGame(rules, CAR, DOG)
+	p := g.players[0]
+	p.position = Field(READING_RAILROAD)
+	p.inventory[Property(READING_RAILROAD)] = STATE_NORMAL
+	if s := p.String(); strings.Count(s, "castle_railroad") != 2 {
+		t.Errorf("Player.String() on a custom board got %q, want the custom field name", s)
+	}
+}
+
+func TestPlayer_repairCost(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG)
+	p := g.players[0]
+	p.inventory = Inventory{
+		MEDITERRANEAN_AVENUE: STATE_HOUSE_2,
+		BALTIC_AVENUE:        STATE_HOTEL,
+		PARK_PLACE:           STATE_NORMAL,
+		BOARDWALK:            STATE_MORTGAGE,
+	}
+
+	if got, want := p.repairCost(40, 115), 2*40+115; got != want {
+		t.Errorf("Player.repairCost() got = %d, want = %d", got, want)
+	}
+}
+
+func TestPlayer_UseJailCard(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG)
+	p, _ := g.GetCurrentPlayer()
+	other := g.players[1-g.currentTurn]
+	g.communityChest = Deck{COMMUNITY_CHEST_GET_OUT_OF_JAIL_FREE}
+
+	p.drawCard(&g.communityChest)
+	if len(g.communityChest) != 0 {
+		t.Fatalf("Community Chest deck has %d cards after drawing Get Out of Jail Free, want 0", len(g.communityChest))
+	}
+	if err := p.TransferJailCard(other, COMMUNITY_CHEST_GET_OUT_OF_JAIL_FREE, 30); err != nil {
+		t.Fatalf("Player.TransferJailCard() got error: %v", err)
+	}
+	if holder, _ := g.GetPlayerForCard(COMMUNITY_CHEST_GET_OUT_OF_JAIL_FREE); holder != other {
+		t.Fatalf("Game.GetPlayerForCard() got %#v, want %#v", holder, other)
+	}
+	if err := p.UseJailCard(); err == nil {
+		t.Error("Player.UseJailCard() without holding a card got no error")
+	}
+
+	g.currentTurn = 1 - g.currentTurn
+	other.goToJail()
+	g.beginTurn()
+	if err := other.UseJailCard(); err != nil {
+		t.Fatalf("Player.UseJailCard() got error: %v", err)
+	}
+	if other.InJail() || len(other.JailCards()) != 0 {
+		t.Errorf("Player after using card is on %#v and holds %#v", other.position, other.JailCards())
+	}
+	if len(g.communityChest) != 1 || g.communityChest[0] != COMMUNITY_CHEST_GET_OUT_OF_JAIL_FREE {
+		t.Errorf("Community Chest deck got %#v after using the card, want it back at the bottom", g.communityChest)
+	}
+}
@@ -2,6 +2,8 @@ package main
 
 import (
 	"fmt"
+	"math/rand"
+	"os"
 	"strings"
 
 	"github.com/Kesuaheli/monopoly"
@@ -15,9 +17,19 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "simulate" {
+		if err := simulate(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	selectedLang, _ = util.SelectableInput(lang.MustLocalize("monopoly.word.language.singular.article.indefinite", selectedLang), lang.AllLangs(), true, func(l language.Tag, i int) bool { util.SelectedLanguage = l; return false })
 	fmt.Printf("\n"+lang.MustLocalize("cli.input.choose", selectedLang)+"\n", lang.MustLocalize("monopoly.word.player.plural", selectedLang))
 	players := make([]monopoly.Token, util.NumberInput(2, len(monopoly.AllTokens())))
+	bots := make(map[monopoly.Token]monopoly.Strategy, len(players))
+	seats := append([]text{text(lang.MustLocalize("cli.bot.human", selectedLang))}, strategyNames()...)
 	for i := range players {
 		id := fmt.Sprintf("%s %d", util.ToUpperFirst(lang.MustLocalize("monopoly.word.player.singular", selectedLang)), i+1)
 		fmt.Printf("\n/%s\\\n| %s |\n\\%s/\n", strings.Repeat("=", len(id)+2), id, strings.Repeat("=", len(id)+2))
@@ -28,48 +40,180 @@ func main() {
 			true,
 			nil,
 		)
+		// the first seat is a human player, all others are bot strategies
+		if _, seat := util.SelectableInput(lang.MustLocalize("cli.bot.seat.singular.article.indefinite", selectedLang), seats, true, nil); seat > 0 {
+			bots[players[i]] = strategies[seat-1].new(rand.Int63())
+		}
 	}
 
-	const turns = 0
-	g := monopoly.NewGame(players...)
+	const maxTurns = 1000
+	rules := monopoly.DefaultRules()
+	rules.Undo.Limit = 20
+	g := monopoly.NewGame(rules, players...)
 	g.SetLanguage(selectedLang)
-	fmt.Printf("\n\nNew game of Monopoly\n%s\n\nsimulating %d random turns...\n\n", g, turns)
+	g.Subscribe(func(e monopoly.Event) {
+		fmt.Printf("  * %s\n", e.Localize(g.Language))
+	})
+	seed, _ := g.Seed()
+	fmt.Printf("\n\nNew game of Monopoly (seed %d)\n%s\n\n", seed, g)
 
+	d := monopoly.NewDriver(g, bots)
 	turn := 0
 	var oldP *monopoly.Player
-	for turn < turns {
-		p, state := g.GetCurrentPlayer()
+	for turn < maxTurns {
+		p, _ := g.GetCurrentPlayer()
 		if p != oldP {
-			fmt.Printf("Turn %d/%d:\n%s\n", turn+1, turns, p)
+			fmt.Printf("\nTurn %d:\n%s\n", turn+1, p)
 			oldP = p
+			turn++
+		}
+		acted, err := d.Step()
+		if err != nil {
+			fmt.Printf("  - %s can't act: %v\n", p.Token(), err)
+			break
 		}
-		fmt.Printf("- %s:\n", state)
-		switch state {
-		case monopoly.GAME_TURN_START:
-			d1, d2, prop := p.RollDice()
-			fmt.Printf("  - Rolled [%d] [%d]: would land on %s\n", d1, d2, prop.Localize(g.Language))
-		case monopoly.GAME_ROLLED_DICE:
-			prop := p.Move()
-			buyable, _ := p.CanBuyProperty()
-			fmt.Printf("  - Landed on %s! Buyable: %t\n", prop.Localize(g.Language), buyable)
-		case monopoly.GAME_MOVED_TO_NEW_FIELD:
-			if buyable, prop := p.CanBuyProperty(); buyable {
-				p.BuyProperty()
-				fmt.Printf("  - Bought %s\n", prop.Localize(g.Language))
-			} else if prop != -1 {
-				fmt.Printf("  - Didn't buy %s\n", prop.Localize(g.Language))
-				p.Continue()
-			} else {
-				p.Continue()
+		if acted {
+			continue
+		}
+		human, ok := waitingHuman(g, players, bots)
+		if !ok || !play(g, human) {
+			break
+		}
+	}
+	if winner, over := g.Winner(); over {
+		fmt.Printf("  - %s won the game\n", winner.Token())
+	}
+
+	fmt.Printf("\n\nEnd of the game after %d turns\n%s\n", turn, g)
+}
+
+// text is an already localized text. It implements [lang.Localizer], so it can be listed by
+// [util.SelectableInput].
+type text string
+
+// Localize implements [lang.Localizer] interface.
+func (t text) Localize(language.Tag) string {
+	return string(t)
+}
+
+// waitingHuman returns the token of the human player the game is waiting for and reports whether
+// it waits for one at all. Players in bots are played by the [monopoly.Driver].
+func waitingHuman(g *monopoly.Game, players []monopoly.Token, bots map[monopoly.Token]monopoly.Strategy) (monopoly.Token, bool) {
+	current, state := g.GetCurrentPlayer()
+	waiting := []*monopoly.Player{current}
+	switch state {
+	case monopoly.GAME_OVER:
+		return 0, false
+	case monopoly.GAME_AUCTION:
+		waiting = nil
+		if a, ok := g.Auction(); ok {
+			_, highestBidder := a.HighestBid()
+			for _, p := range g.Players() {
+				if !p.Bankrupt() && !a.Passed(p) && p != highestBidder {
+					waiting = append(waiting, p)
+				}
 			}
-		case monopoly.GAME_TURN:
-			fmt.Print("  - Ended turn\n")
-			if again := p.EndTurn(); !again {
-				turn++
+		}
+	case monopoly.GAME_DEBT:
+		waiting = g.Debtors()
+	case monopoly.GAME_TRADE:
+		if tp, ok := g.PendingTrade(); ok {
+			waiting = []*monopoly.Player{tp.To}
+		}
+	}
+
+	for _, p := range waiting {
+		for _, t := range players {
+			if _, isBot := bots[t]; !isBot && g.GetPlayer(t) == p {
+				return t, true
 			}
 		}
+	}
+	return 0, false
+}
+
+// play lets the human player with the token t choose one of their legal actions and applies it, or
+// undo or redo the last action. It reports whether the player had anything to choose from. Giving
+// away properties and proposing trades is not supported on the command line.
+func play(g *monopoly.Game, t monopoly.Token) bool {
+	var actions []monopoly.Action
+	var descriptions []text
+	for _, a := range g.LegalActions(t) {
+		switch a.Kind {
+		case monopoly.ACTION_TRANSFER_PROPERTY, monopoly.ACTION_TRANSFER_JAIL_CARD, monopoly.ACTION_PROPOSE_TRADE:
+			continue
+		}
+		actions = append(actions, a)
+		descriptions = append(descriptions, describe(g, a))
+	}
+	if len(actions) == 0 {
+		return false
+	}
 
+	canUndo, canRedo := g.CanUndo(), g.CanRedo()
+	if canUndo {
+		descriptions = append(descriptions, text(lang.MustLocalize("cli.action.undo", selectedLang)))
+	}
+	if canRedo {
+		descriptions = append(descriptions, text(lang.MustLocalize("cli.action.redo", selectedLang)))
 	}
 
-	fmt.Printf("\n\nEnd of %d turns\n%s\n", turns, g)
+	if len(descriptions) > 1 {
+		fmt.Printf("\n%s\n", g.GetPlayer(t))
+	}
+	_, i := util.SelectableInput(lang.MustLocalize("cli.action.singular.article.indefinite", selectedLang), descriptions, true, func(text, int) bool { return true })
+	var err error
+	switch {
+	case i < len(actions):
+		_, err = g.Apply(actions[i])
+	case i == len(actions) && canUndo:
+		var a monopoly.Action
+		if a, err = g.Undo(); err == nil {
+			fmt.Printf("  * %s: %s\n", lang.MustLocalize("cli.action.undone", selectedLang), describe(g, a))
+		}
+	default:
+		var a monopoly.Action
+		if a, err = g.Redo(); err == nil {
+			fmt.Printf("  * %s: %s\n", lang.MustLocalize("cli.action.redone", selectedLang), describe(g, a))
+		}
+	}
+	if err != nil {
+		fmt.Printf("  - %v\n", err)
+	}
+	return true
+}
+
+// describe returns the localized description of the action a.
+func describe(g *monopoly.Game, a monopoly.Action) text {
+	kind, _ := a.Kind.MarshalText()
+	format := lang.MustLocalize("cli.action."+string(kind), selectedLang)
+	switch a.Kind {
+	case monopoly.ACTION_MORTGAGE_PROPERTY, monopoly.ACTION_CANCEL_MORTGAGE_PROPERTY, monopoly.ACTION_BUY_HOUSE, monopoly.ACTION_SELL_HOUSE:
+		return text(fmt.Sprintf(format, g.Board().Localize(monopoly.Field(a.Property), selectedLang)))
+	case monopoly.ACTION_AUCTION_BUILDING:
+		return text(fmt.Sprintf(format, a.Building.Localize(selectedLang)))
+	case monopoly.ACTION_BID:
+		return text(fmt.Sprintf(format, g.FormatCurrency(a.Amount)))
+	}
+	return text(format)
+}
+
+// strategies are the bot strategies to choose from. The name is used on the command line and in
+// the lang key "cli.bot.<name>".
+var strategies = []struct {
+	name string
+	new  func(seed int64) monopoly.Strategy
+}{
+	{"greedy", func(int64) monopoly.Strategy { return monopoly.GreedyStrategy{} }},
+	{"conservative", func(int64) monopoly.Strategy { return monopoly.ConservativeStrategy{Reserve: 200} }},
+	{"random", func(seed int64) monopoly.Strategy { return monopoly.NewRandomStrategy(seed) }},
+}
+
+// strategyNames returns the localized names of all strategies.
+func strategyNames() []text {
+	names := make([]text, len(strategies))
+	for i, s := range strategies {
+		names[i] = text(lang.MustLocalize("cli.bot."+s.name, selectedLang))
+	}
+	return names
 }
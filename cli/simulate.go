@@ -0,0 +1,282 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Kesuaheli/monopoly"
+	"golang.org/x/text/language"
+)
+
+// bankruptcyCauses are the causes a bankruptcy is attributed to in a simulation.
+var bankruptcyCauses = []string{"rent", "tax", "card", "other"}
+
+// gameResult are the statistics of a single simulated game.
+type gameResult struct {
+	turns    int
+	winner   int // seat of the winner, -1 if the game didn't finish
+	causes   map[string]int
+	landings []int
+	err      error
+}
+
+// simulate runs the "simulate" command with the command line arguments args: it plays complete
+// bot-vs-bot games in parallel and reports statistics about them.
+func simulate(args []string) error {
+	fs := flag.NewFlagSet("simulate", flag.ExitOnError)
+	games := fs.Int("games", 100, "number of games to simulate")
+	parallel := fs.Int("parallel", runtime.NumCPU(), "number of games to simulate at the same time")
+	seed := fs.Int64("seed", 0, "seed of the first game, every further game uses the next seed (0 for a random seed)")
+	maxTurns := fs.Int("max-turns", 1000, "number of turns after which an unfinished game is stopped")
+	strategyList := fs.String("strategies", "greedy,conservative,random", "comma separated strategies of the players, one of "+strings.Join(strategyKeys(), ", "))
+	rulesFile := fs.String("rules", "", "JSON file with rules to play, missing values are taken from the official rules")
+	boardFile := fs.String("board", "", "YAML file with the board to play on")
+	houseRules := fs.String("house-rules", "", "comma separated house rules to play, e.g. free_parking_jackpot")
+	fs.Parse(args)
+
+	rules, err := simulationRules(*rulesFile, *boardFile, *houseRules)
+	if err != nil {
+		return err
+	}
+	var seats []int
+	for _, name := range strings.Split(*strategyList, ",") {
+		i := slices.Index(strategyKeys(), strings.TrimSpace(name))
+		if i == -1 {
+			return fmt.Errorf("unknown strategy %q", name)
+		}
+		seats = append(seats, i)
+	}
+	if len(seats) < 2 || len(seats) > len(monopoly.AllTokens()) {
+		return fmt.Errorf("need 2 to %d strategies, got %d", len(monopoly.AllTokens()), len(seats))
+	}
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+	}
+
+	start := time.Now()
+	jobs := make(chan int64)
+	results := make(chan gameResult)
+	var wg sync.WaitGroup
+	for i := 0; i < max(*parallel, 1); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for gameSeed := range jobs {
+				results <- simulateGame(rules, seats, gameSeed, *maxTurns)
+			}
+		}()
+	}
+	go func() {
+		for i := 0; i < *games; i++ {
+			jobs <- *seed + int64(i)
+		}
+		close(jobs)
+		wg.Wait()
+		close(results)
+	}()
+
+	var (
+		finished, totalTurns int
+		wins                 = make([]int, len(strategies))
+		plays                = make([]int, len(strategies))
+		causes               = map[string]int{}
+		landings             = make([]int, int(monopoly.IN_JAIL)+1)
+	)
+	for r := range results {
+		if r.err != nil {
+			err = errors.Join(err, r.err)
+			continue
+		}
+		for _, s := range seats {
+			plays[s]++
+		}
+		if r.winner != -1 {
+			finished++
+			totalTurns += r.turns
+			wins[seats[r.winner]]++
+		}
+		for cause, n := range r.causes {
+			causes[cause] += n
+		}
+		for f, n := range r.landings {
+			landings[f] += n
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Simulated %d games with %s (seed %d) in %s\n", *games, *strategyList, *seed, time.Since(start).Round(time.Millisecond))
+	fmt.Printf("Finished: %d, stopped after %d turns: %d\n", finished, *maxTurns, *games-finished)
+	if finished != 0 {
+		fmt.Printf("Average length: %.1f turns\n", float64(totalTurns)/float64(finished))
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "\nStrategy\tSeats\tWins\tWin rate\t")
+	for i, s := range strategies {
+		if plays[i] != 0 {
+			fmt.Fprintf(w, "%s\t%d\t%d\t%s\t\n", s.name, plays[i], wins[i], percent(wins[i], plays[i]))
+		}
+	}
+	w.Flush()
+
+	var bankruptcies int
+	for _, n := range causes {
+		bankruptcies += n
+	}
+	fmt.Fprintln(w, "\nBankruptcy cause\tCount\tShare\t")
+	for _, cause := range bankruptcyCauses {
+		fmt.Fprintf(w, "%s\t%d\t%s\t\n", cause, causes[cause], percent(causes[cause], bankruptcies))
+	}
+	w.Flush()
+
+	var totalLandings int
+	for _, n := range landings {
+		totalLandings += n
+	}
+	fmt.Fprintln(w, "\nField\tLandings\tShare\t")
+	for f, n := range landings {
+		fmt.Fprintf(w, "%s\t%d\t%s\t\n", rules.Board.Localize(monopoly.Field(f), language.AmericanEnglish), n, percent(n, totalLandings))
+	}
+	return w.Flush()
+}
+
+// simulateGame plays a single game with a bot of the strategy seats[i] for the i-th player, until it
+// is over or maxTurns turns were played.
+func simulateGame(rules monopoly.Rules, seats []int, seed int64, maxTurns int) gameResult {
+	rules.Random = monopoly.NewSeededSource(seed)
+	tokens := monopoly.AllTokens()[:len(seats)]
+	g := monopoly.NewGame(rules, tokens...)
+
+	bots := make(map[monopoly.Token]monopoly.Strategy, len(seats))
+	for i, s := range seats {
+		bots[tokens[i]] = strategies[s].new(seed*int64(len(seats)) + int64(i))
+	}
+	r := gameResult{
+		winner:   -1,
+		causes:   map[string]int{},
+		landings: make([]int, int(monopoly.IN_JAIL)+1),
+	}
+
+	// cause is the last reason each player had to pay money, reset by every roll of the dice.
+	cause := map[*monopoly.Player]string{}
+	g.Subscribe(func(e monopoly.Event) {
+		switch e := e.(type) {
+		case monopoly.DiceRolledEvent:
+			clear(cause)
+		case monopoly.MovedEvent:
+			r.landings[e.To]++
+		case monopoly.WentToJailEvent:
+			r.landings[monopoly.IN_JAIL]++
+		case monopoly.RentPaidEvent:
+			cause[e.Player] = "rent"
+		case monopoly.TaxPaidEvent:
+			cause[e.Player] = "tax"
+		case monopoly.CardDrawnEvent:
+			// cards may make every player pay
+			for _, p := range g.Players() {
+				cause[p] = "card"
+			}
+		case monopoly.BankruptEvent:
+			if c, ok := cause[e.Player]; ok {
+				r.causes[c]++
+			} else {
+				r.causes["other"]++
+			}
+		}
+	})
+
+	d := monopoly.NewDriver(g, bots)
+	last, _ := g.GetCurrentPlayer()
+	for r.turns < maxTurns {
+		acted, err := d.Step()
+		if err != nil {
+			r.err = fmt.Errorf("game with seed %d: %w", seed, err)
+			return r
+		}
+		if !acted {
+			break
+		}
+		if p, _ := g.GetCurrentPlayer(); p != last {
+			r.turns++
+			last = p
+		}
+	}
+
+	if winner, over := g.Winner(); over {
+		r.winner = slices.IndexFunc(tokens, func(t monopoly.Token) bool { return g.GetPlayer(t) == winner })
+	}
+	return r
+}
+
+// simulationRules returns the official rules, overwritten by the JSON rules in the file rulesFile,
+// played on the YAML board in boardFile and with the comma separated houseRules enabled.
+func simulationRules(rulesFile, boardFile, houseRules string) (monopoly.Rules, error) {
+	rules := monopoly.DefaultRules()
+	if rulesFile != "" {
+		data, err := os.ReadFile(rulesFile)
+		if err != nil {
+			return rules, err
+		}
+		rules.Board = nil // the default board is shared and must not be overwritten
+		if err := json.Unmarshal(data, &rules); err != nil {
+			return rules, fmt.Errorf("parse rules: %w", err)
+		}
+		if rules.Board == nil {
+			rules.Board = monopoly.DefaultBoard()
+		} else if err := rules.Board.Validate(); err != nil {
+			return rules, err
+		}
+	}
+	if boardFile != "" {
+		f, err := os.Open(boardFile)
+		if err != nil {
+			return rules, err
+		}
+		defer f.Close()
+		if rules.Board, err = monopoly.LoadBoard(f); err != nil {
+			return rules, err
+		}
+	}
+	if houseRules == "" {
+		return rules, nil
+	}
+	for _, name := range strings.Split(houseRules, ",") {
+		i := slices.IndexFunc(monopoly.AllHouseRules(), func(hr monopoly.HouseRule) bool {
+			return strings.EqualFold(hr.GoString(), strings.TrimSpace(name))
+		})
+		if i == -1 {
+			return rules, fmt.Errorf("unknown house rule %q", name)
+		}
+		rules.HouseRules |= monopoly.AllHouseRules()[i]
+	}
+	return rules, nil
+}
+
+// strategyKeys returns the names of all strategies as used on the command line.
+func strategyKeys() []string {
+	keys := make([]string, len(strategies))
+	for i, s := range strategies {
+		keys[i] = s.name
+	}
+	return keys
+}
+
+// percent formats n of total as a percentage.
+func percent(n, total int) string {
+	if total == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", 100*float64(n)/float64(total))
+}
@@ -0,0 +1,100 @@
+package monopoly
+
+import (
+	"github.com/Kesuaheli/monopoly/lang"
+	"golang.org/x/text/language"
+)
+
+// ColorGroup is a group of streets sharing the same color. Houses can only be built on a street
+// when its owner owns every street of the group.
+type ColorGroup int8
+
+const (
+	BROWN ColorGroup = iota
+	LIGHT_BLUE
+	PINK
+	ORANGE
+	RED
+	YELLOW
+	GREEN
+	DARK_BLUE
+)
+
+// AllColorGroups returns a slice of all color groups.
+func AllColorGroups() []ColorGroup {
+	return []ColorGroup{
+		BROWN,
+		LIGHT_BLUE,
+		PINK,
+		ORANGE,
+		RED,
+		YELLOW,
+		GREEN,
+		DARK_BLUE,
+	}
+}
+
+// Properties returns all streets belonging to cg on the classic board.
+func (cg ColorGroup) Properties() []Property {
+	return defaultBoard.Properties(cg)
+}
+
+// ColorGroup returns the color group of p on the classic board and reports whether p is a street.
+// Railroads and utilities don't belong to any color group.
+func (p Property) ColorGroup() (ColorGroup, bool) {
+	return defaultBoard.ColorGroup(p)
+}
+
+// String returns the english name for cg.
+// String implements [fmt.Stringer] interface.
+func (cg ColorGroup) String() string {
+	return cg.Localize(language.English)
+}
+
+// Localize returns the localized name for cg in the language langTag.
+func (cg ColorGroup) Localize(langTag language.Tag) string {
+	switch cg {
+	case BROWN:
+		return lang.MustLocalize("monopoly.color_group.brown", langTag)
+	case LIGHT_BLUE:
+		return lang.MustLocalize("monopoly.color_group.light_blue", langTag)
+	case PINK:
+		return lang.MustLocalize("monopoly.color_group.pink", langTag)
+	case ORANGE:
+		return lang.MustLocalize("monopoly.color_group.orange", langTag)
+	case RED:
+		return lang.MustLocalize("monopoly.color_group.red", langTag)
+	case YELLOW:
+		return lang.MustLocalize("monopoly.color_group.yellow", langTag)
+	case GREEN:
+		return lang.MustLocalize("monopoly.color_group.green", langTag)
+	case DARK_BLUE:
+		return lang.MustLocalize("monopoly.color_group.dark_blue", langTag)
+	default:
+		return lang.MustLocalize("unknown", langTag)
+	}
+}
+
+// GoString implements [fmt.GoStringer] interface.
+func (cg ColorGroup) GoString() string {
+	switch cg {
+	case BROWN:
+		return "BROWN"
+	case LIGHT_BLUE:
+		return "LIGHT_BLUE"
+	case PINK:
+		return "PINK"
+	case ORANGE:
+		return "ORANGE"
+	case RED:
+		return "RED"
+	case YELLOW:
+		return "YELLOW"
+	case GREEN:
+		return "GREEN"
+	case DARK_BLUE:
+		return "DARK_BLUE"
+	default:
+		return "UNKNOWN"
+	}
+}
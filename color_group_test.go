@@ -0,0 +1,79 @@
+package monopoly
+
+import "testing"
+
+func TestProperty_ColorGroup(t *testing.T) {
+	var streets int
+	for _, cg := range AllColorGroups() {
+		for _, prop := range cg.Properties() {
+			streets++
+			if got, ok := prop.ColorGroup(); !ok || got != cg {
+				t.Errorf("%#v.ColorGroup() got = (%#v, %t), want (%#v, true)", prop, got, ok, cg)
+			}
+		}
+	}
+	if streets != 22 {
+		t.Errorf("color groups contain %d streets, want 22", streets)
+	}
+	if cg, ok := Property(READING_RAILROAD).ColorGroup(); ok {
+		t.Errorf("READING_RAILROAD.ColorGroup() got = (%#v, true), want (-1, false)", cg)
+	}
+}
+
+func TestPlayer_CanBuildHouse(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG)
+	p := g.players[0]
+	p.inventory[MEDITERRANEAN_AVENUE] = STATE_NORMAL
+	if p.CanBuildHouse(MEDITERRANEAN_AVENUE) {
+		t.Error("Player.CanBuildHouse() without the whole color group got = true, want false")
+	}
+
+	p.inventory[BALTIC_AVENUE] = STATE_MORTGAGE
+	if p.CanBuildHouse(MEDITERRANEAN_AVENUE) {
+		t.Error("Player.CanBuildHouse() with a mortgaged street in the group got = true, want false")
+	}
+
+	p.inventory[BALTIC_AVENUE] = STATE_NORMAL
+	if _, err := p.BuyHouse(MEDITERRANEAN_AVENUE); err != nil {
+		t.Fatalf("Player.BuyHouse() on a complete color group got error: %v", err)
+	}
+	if p.CanBuildHouse(MEDITERRANEAN_AVENUE) {
+		t.Error("Player.CanBuildHouse() with uneven houses got = true, want false")
+	}
+	if p.CanSellHouse(BALTIC_AVENUE) {
+		t.Error("Player.CanSellHouse() on a street with less houses got = true, want false")
+	}
+	if err := p.MortgageProperty(BALTIC_AVENUE); err != ErrBuildingsInGroup {
+		t.Errorf("Player.MortgageProperty() with houses in the group got error %v, want %v", err, ErrBuildingsInGroup)
+	}
+	if _, err := p.BuyHouse(BALTIC_AVENUE); err != nil {
+		t.Errorf("Player.BuyHouse() evening out the group got error: %v", err)
+	}
+}
+
+func TestPlayer_TransferProperty_buildings(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG)
+	p, _ := g.GetCurrentPlayer()
+	other := g.players[1-g.currentTurn]
+	p.inventory[PARK_PLACE] = STATE_HOUSE_2
+	p.inventory[BOARDWALK] = STATE_HOUSE_2
+	g.state = GAME_TURN
+
+	if err := p.TransferProperty(other, PARK_PLACE, 0); err != ErrBuildingsInGroup {
+		t.Errorf("Player.TransferProperty() of a street with houses got error %v, want %v", err, ErrBuildingsInGroup)
+	}
+	a := Action{Kind: ACTION_TRANSFER_PROPERTY, Player: p.token, Property: BOARDWALK, Target: other.token}
+	p.inventory[BOARDWALK] = STATE_NORMAL
+	p.inventory[PARK_PLACE] = STATE_HOUSE_1
+	if _, err := g.Apply(a); err != ErrBuildingsInGroup {
+		t.Errorf("Game.Apply(TRANSFER_PROPERTY) of a street in a group with houses got error %v, want %v", err, ErrBuildingsInGroup)
+	}
+	if len(other.inventory) != 0 {
+		t.Errorf("receiving player got inventory %#v", other.inventory)
+	}
+
+	p.inventory[PARK_PLACE] = STATE_NORMAL
+	if _, err := g.Apply(a); err != nil {
+		t.Errorf("Game.Apply(TRANSFER_PROPERTY) without houses got error: %v", err)
+	}
+}
@@ -0,0 +1,314 @@
+package monopoly
+
+// debt is an amount of money a player still owes to a creditor.
+type debt struct {
+	creditor *Player // nil if the money is owed to the bank
+	amount   int
+	fee      bool // whether the money goes into the Free Parking jackpot
+}
+
+// pay makes p pay amount to creditor. A nil creditor is the bank. If p can't afford the full amount,
+// p pays as much as they have and the rest is noted as a debt.
+func (p *Player) pay(amount int, creditor *Player) {
+	p.payDebt(debt{creditor: creditor, amount: amount})
+}
+
+// payFee makes p pay a tax or fee of amount to the bank. With the [FREE_PARKING_JACKPOT] house rule
+// the money goes into the jackpot instead.
+func (p *Player) payFee(amount int) {
+	p.payDebt(debt{amount: amount, fee: true})
+}
+
+// payDebt makes p pay d. If p can't afford the full amount, p pays as much as they have and the rest
+// is noted as a debt. Bankrupt players neither pay nor receive money.
+func (p *Player) payDebt(d debt) {
+	if p.bankrupt || d.creditor != nil && d.creditor.bankrupt {
+		return
+	}
+	paid := min(d.amount, max(p.money, 0))
+	p.money -= paid
+	if d.creditor != nil {
+		d.creditor.money += paid
+	} else if d.fee && p.game.rules.HouseRules.Has(FREE_PARKING_JACKPOT) {
+		p.game.jackpot += paid
+	}
+	if paid < d.amount {
+		d.amount -= paid
+		p.debts = append(p.debts, d)
+	}
+}
+
+// checkDebts starts the debt resolution, if any player is in debt.
+func (g *Game) checkDebts() {
+	if g.state == GAME_DEBT || len(g.debtors()) == 0 {
+		return
+	}
+	g.resumeState = g.state
+	g.state = GAME_DEBT
+}
+
+// resolveDebts ends the debt resolution, once no player is in debt anymore.
+func (g *Game) resolveDebts() {
+	if g.state != GAME_DEBT || len(g.debtors()) != 0 {
+		return
+	}
+	g.state = g.resumeState
+}
+
+// Debtors returns all players who currently owe money.
+func (g *Game) Debtors() []*Player {
+	g.lock()
+	defer g.unlock()
+	return g.debtors()
+}
+
+// debtors is like Debtors, but expects g to be locked.
+func (g *Game) debtors() []*Player {
+	var debtors []*Player
+	for _, p := range g.players {
+		if len(p.debts) != 0 {
+			debtors = append(debtors, p)
+		}
+	}
+	return debtors
+}
+
+// Winner returns the last player that is not bankrupt and reports whether the game is over.
+func (g *Game) Winner() (*Player, bool) {
+	g.lock()
+	defer g.unlock()
+	if g.state != GAME_OVER {
+		return nil, false
+	}
+	for _, p := range g.players {
+		if !p.bankrupt {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+// Debt returns the total amount of money p still owes.
+func (p *Player) Debt() int {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.totalDebt()
+}
+
+// totalDebt is like Debt, but expects the game to be locked.
+func (p *Player) totalDebt() int {
+	var total int
+	for _, d := range p.debts {
+		total += d.amount
+	}
+	return total
+}
+
+// Bankrupt reports whether p went bankrupt and is out of the game.
+func (p *Player) Bankrupt() bool {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.bankrupt
+}
+
+// PayDebts makes p pay all of their debts. This is only possible when p raised enough money, e.g. by
+// mortgaging properties or selling houses.
+func (p *Player) PayDebts() error {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.payDebts()
+}
+
+// payDebts is like PayDebts, but expects the game to be locked.
+func (p *Player) payDebts() error {
+	if err := p.canPayDebts(); err != nil {
+		return err
+	}
+	p.game.record(Action{Kind: ACTION_PAY_DEBTS, Player: p.token})
+
+	debts := p.debts
+	p.debts = nil
+	for _, d := range debts {
+		p.payDebt(d)
+	}
+	p.game.resolveDebts()
+	return nil
+}
+
+// canPayDebts returns an error why p can't pay all of their debts.
+func (p *Player) canPayDebts() error {
+	if len(p.debts) == 0 {
+		return ErrNoDebts
+	}
+	return p.checkFunds(p.totalDebt())
+}
+
+// DeclareBankruptcy makes p go bankrupt, when they can't raise enough money to pay their debts. The
+// buildings of p are sold to the bank first, buildings won in auctions but not built yet go back
+// to the bank and p's money pays the debts in the order they arose.
+// Then p's properties in the order of the board and p's Get Out of Jail Free cards go one by one to
+// the creditor with the largest remaining debt, each property counting with its mortgage value.
+// Properties and cards going to the bank, or left after all debts are settled, become available
+// again.
+func (p *Player) DeclareBankruptcy() error {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.declareBankruptcy()
+}
+
+// declareBankruptcy is like DeclareBankruptcy, but expects the game to be locked.
+func (p *Player) declareBankruptcy() error {
+	if err := p.canDeclareBankruptcy(); err != nil {
+		return err
+	}
+	p.game.record(Action{Kind: ACTION_DECLARE_BANKRUPTCY, Player: p.token})
+	creditor := p.debts[0].creditor
+
+	var props []Property
+	for f := GO; int(f) < numberOfFields; f++ {
+		prop, ok := p.game.board.Property(f)
+		if !ok {
+			continue
+		}
+		state, hasProp := p.inventory[prop]
+		if !hasProp {
+			continue
+		}
+		if state > STATE_NORMAL {
+			p.money += int(state) * p.game.board.HouseCost(prop) / 2
+			p.game.returnBuildings(state)
+			p.inventory[prop] = STATE_NORMAL
+		}
+		props = append(props, prop)
+	}
+	p.game.houses += p.reserved[HOUSE]
+	p.game.hotels += p.reserved[HOTEL]
+	p.reserved = [2]int{}
+	cards := p.jailCards()
+
+	// paying with money notes the rest of each debt again
+	debts := p.debts
+	p.debts = nil
+	for _, d := range debts {
+		p.payDebt(d)
+	}
+	owed := make([]int, len(p.debts))
+	for i, d := range p.debts {
+		owed[i] = d.amount
+	}
+	creditorFor := func(value int) *Player {
+		i := largestDebt(owed)
+		if i == -1 {
+			return nil
+		}
+		owed[i] -= value
+		return p.debts[i].creditor
+	}
+	for _, prop := range props {
+		var value int
+		if p.inventory[prop] != STATE_MORTGAGE {
+			value = p.game.board.MortgageValue(prop)
+		}
+		p.handOverProperty(prop, creditorFor(value))
+	}
+	for _, c := range cards {
+		p.handOverJailCard(c, creditorFor(0))
+	}
+
+	p.money = 0
+	p.debts = nil
+	p.bankrupt = true
+	p.game.emit(BankruptEvent{Player: p, Creditor: creditor})
+
+	var remaining int
+	for _, other := range p.game.players {
+		if !other.bankrupt {
+			remaining++
+		}
+	}
+	if remaining <= 1 {
+		p.game.state = GAME_OVER
+		return nil
+	}
+
+	if p.game.players[p.game.currentTurn] == p {
+		p.game.doubblesCount = 0
+		p.game.nextPlayer()
+		p.game.beginTurn()
+		p.game.checkDebts()
+		return nil
+	}
+	p.game.resolveDebts()
+	return nil
+}
+
+// handOverProperty gives prop from the bankrupt player p to creditor. A nil creditor is the bank,
+// which makes prop available again.
+func (p *Player) handOverProperty(prop Property, creditor *Player) {
+	if creditor != nil {
+		creditor.inventory[prop] = p.inventory[prop]
+	}
+	delete(p.inventory, prop)
+}
+
+// handOverJailCard gives the Get Out of Jail Free card c from the bankrupt player p to creditor. A
+// nil creditor is the bank, which puts c back into its deck.
+func (p *Player) handOverJailCard(c Card, creditor *Player) {
+	if creditor != nil {
+		p.game.jailCards[c] = creditor
+		return
+	}
+	delete(p.game.jailCards, c)
+	p.game.deck(c).putBack(c)
+}
+
+// largestDebt returns the index of the largest positive amount in owed, or -1 if there is none.
+func largestDebt(owed []int) int {
+	largest := -1
+	for i, amount := range owed {
+		if amount > 0 && (largest == -1 || amount > owed[largest]) {
+			largest = i
+		}
+	}
+	return largest
+}
+
+// liquidationValue returns the money p could raise by selling all buildings and mortgaging all
+// properties. A hotel the bank has no houses to replace with can't be sold, so its color group
+// can't be mortgaged either.
+func (p *Player) liquidationValue() int {
+	blocked := map[ColorGroup]bool{}
+	for prop, state := range p.inventory {
+		if cg, isStreet := p.game.board.ColorGroup(prop); isStreet && state == STATE_HOTEL && p.game.houses < 4 {
+			blocked[cg] = true
+		}
+	}
+
+	value := p.money
+	for prop, state := range p.inventory {
+		if cg, isStreet := p.game.board.ColorGroup(prop); isStreet && blocked[cg] {
+			continue
+		}
+		if state > STATE_NORMAL {
+			value += int(state) * p.game.board.HouseCost(prop) / 2
+		}
+		if state != STATE_MORTGAGE {
+			value += p.game.board.MortgageValue(prop)
+		}
+	}
+	return value
+}
+
+// canDeclareBankruptcy returns an error why p can't declare bankruptcy.
+func (p *Player) canDeclareBankruptcy() error {
+	if p.bankrupt {
+		return ErrBankrupt
+	}
+	if len(p.debts) == 0 {
+		return ErrNoDebts
+	}
+	if p.liquidationValue() >= p.totalDebt() {
+		return ErrDebtsPayable
+	}
+	return nil
+}
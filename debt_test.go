@@ -0,0 +1,136 @@
+package monopoly
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestPlayer_PayDebts(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG)
+	p, _ := g.GetCurrentPlayer()
+	owner := g.players[1-g.currentTurn]
+	p.money = 10
+	p.inventory[BOARDWALK] = STATE_NORMAL
+	g.state = GAME_MOVED_TO_NEW_FIELD
+
+	p.pay(100, owner)
+	g.checkDebts()
+	if p.money != 0 || p.Debt() != 90 || owner.money != defaultRules.StartMoney+10 {
+		t.Fatalf("after paying 100 with 10 money: money = %d, debt = %d, creditor money = %d", p.money, p.Debt(), owner.money)
+	}
+	if g.state != GAME_DEBT {
+		t.Fatalf("Game state got %#v, want %#v", g.state, GAME_DEBT)
+	}
+	var fundsErr *InsufficientFundsError
+	if err := p.PayDebts(); !errors.As(err, &fundsErr) || fundsErr.Required != 90 || fundsErr.Available != 0 {
+		t.Fatalf("Player.PayDebts() without money got error %v, want insufficient funds", err)
+	}
+
+	p.MortgageProperty(BOARDWALK)
+	if err := p.PayDebts(); err != nil {
+		t.Fatalf("Player.PayDebts() after mortgaging got error: %v", err)
+	}
+	if p.money != BOARDWALK.GetMortgageValue()-90 || owner.money != defaultRules.StartMoney+100 {
+		t.Errorf("after paying debts: money = %d, creditor money = %d", p.money, owner.money)
+	}
+	if g.state != GAME_MOVED_TO_NEW_FIELD {
+		t.Errorf("Game state got %#v, want %#v", g.state, GAME_MOVED_TO_NEW_FIELD)
+	}
+}
+
+func TestPlayer_DeclareBankruptcy(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG)
+	p, _ := g.GetCurrentPlayer()
+	creditor := g.players[1-g.currentTurn]
+	p.money = 0
+	p.inventory[PARK_PLACE] = STATE_HOUSE_2
+	p.inventory[BOARDWALK] = STATE_MORTGAGE
+	g.jailCards[CHANCE_GET_OUT_OF_JAIL_FREE] = p
+
+	p.pay(500, creditor)
+	g.checkDebts()
+	var events []Event
+	g.Subscribe(func(e Event) { events = append(events, e) })
+	if err := p.DeclareBankruptcy(); err != nil {
+		t.Fatalf("Player.DeclareBankruptcy() got error: %v", err)
+	}
+	if want := (BankruptEvent{Player: p, Creditor: creditor}); len(events) != 1 || events[0] != want {
+		t.Errorf("Player.DeclareBankruptcy() emitted %v, want %v", events, want)
+	}
+
+	if !p.Bankrupt() || len(p.inventory) != 0 || len(p.JailCards()) != 0 {
+		t.Errorf("bankrupt player still has %#v", p)
+	}
+	if creditor.inventory[PARK_PLACE] != STATE_NORMAL || creditor.inventory[BOARDWALK] != STATE_MORTGAGE {
+		t.Errorf("creditor got inventory %#v", creditor.inventory)
+	}
+	if want := defaultRules.StartMoney + 2*PARK_PLACE.GetHouseCost()/2; creditor.money != want {
+		t.Errorf("creditor has %d money, want %d", creditor.money, want)
+	}
+	if len(creditor.JailCards()) != 1 {
+		t.Errorf("creditor holds %#v, want the Get Out of Jail Free card", creditor.JailCards())
+	}
+	if winner, over := g.Winner(); !over || winner != creditor {
+		t.Errorf("Game.Winner() got = (%#v, %t), want (%#v, true)", winner, over, creditor)
+	}
+}
+
+func TestPlayer_DeclareBankruptcy_reserved(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG, HAT)
+	p, _ := g.GetCurrentPlayer()
+	creditor := g.players[(g.currentTurn+1)%3]
+	p.money = 0
+	p.reserved = [2]int{HOUSE: 2, HOTEL: 1}
+	g.houses, g.hotels = 30, 11
+
+	p.pay(500, creditor)
+	g.checkDebts()
+	if err := p.DeclareBankruptcy(); err != nil {
+		t.Fatalf("Player.DeclareBankruptcy() got error: %v", err)
+	}
+	if p.reserved != [2]int{} || g.houses != 32 || g.hotels != 12 {
+		t.Errorf("after bankruptcy: reserved = %v, bank has %d houses and %d hotels, want 32 and 12", p.reserved, g.houses, g.hotels)
+	}
+}
+
+func TestPlayer_DeclareBankruptcy_creditors(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG, HAT)
+	p, _ := g.GetCurrentPlayer()
+	c1, c2 := g.players[(g.currentTurn+1)%3], g.players[(g.currentTurn+2)%3]
+	p.money = 0
+	p.inventory[BOARDWALK] = STATE_NORMAL
+	g.state = GAME_MOVED_TO_NEW_FIELD
+
+	p.pay(100, c1)
+	g.checkDebts()
+	if err := p.DeclareBankruptcy(); err != ErrDebtsPayable {
+		t.Fatalf("Player.DeclareBankruptcy() with enough assets got error %v, want %v", err, ErrDebtsPayable)
+	}
+
+	p.inventory[MEDITERRANEAN_AVENUE] = STATE_NORMAL
+	p.inventory[Property(READING_RAILROAD)] = STATE_MORTGAGE
+	p.inventory[PARK_PLACE] = STATE_NORMAL
+	g.jailCards[CHANCE_GET_OUT_OF_JAIL_FREE] = p
+	p.debts = nil
+	p.pay(120, c1)
+	p.pay(250, c2)
+	p.pay(150, nil)
+	if err := p.DeclareBankruptcy(); err != nil {
+		t.Fatalf("Player.DeclareBankruptcy() got error: %v", err)
+	}
+
+	want := Inventory{MEDITERRANEAN_AVENUE: STATE_NORMAL, Property(READING_RAILROAD): STATE_MORTGAGE, PARK_PLACE: STATE_NORMAL}
+	if !reflect.DeepEqual(c2.inventory, want) {
+		t.Errorf("creditor with the largest debt got inventory %#v, want %#v", c2.inventory, want)
+	}
+	if !g.IsPropertyAvailable(BOARDWALK) {
+		t.Error("property settling the debt to the bank is not available")
+	}
+	if holder, ok := g.jailCards[CHANCE_GET_OUT_OF_JAIL_FREE]; !ok || holder != c1 {
+		t.Errorf("Get Out of Jail Free card is held by %#v, want %#v", holder, c1)
+	}
+	if g.state != GAME_TURN_START && g.state != GAME_IN_JAIL {
+		t.Errorf("Game state got %#v, want the next players turn", g.state)
+	}
+}
@@ -0,0 +1,131 @@
+package monopoly
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Errors returned by the actions of a [Player], when the action is not allowed. Errors with more
+// context wrap one of these values or are of the type [*StateError] or [*InsufficientFundsError].
+var (
+	ErrNotYourTurn          = errors.New("it's not the player's turn")
+	ErrBankrupt             = errors.New("player is bankrupt")
+	ErrNotAProperty         = errors.New("field is not a property")
+	ErrNotAStreet           = errors.New("property is not a street")
+	ErrPropertyOwned        = errors.New("property is already owned")
+	ErrNotOwner             = errors.New("property is not owned by the player")
+	ErrFirstLap             = errors.New("properties can't be bought before crossing GO")
+	ErrPropertyMortgaged    = errors.New("property is mortgaged")
+	ErrPropertyNotMortgaged = errors.New("property is not mortgaged")
+	ErrBuildingsInGroup     = errors.New("color group has buildings")
+	ErrNoMonopoly           = errors.New("player doesn't own the whole color group")
+	ErrUnevenBuilding       = errors.New("buildings have to be built and sold evenly")
+	ErrMaxBuildings         = errors.New("street already has a hotel")
+	ErrNoBuildings          = errors.New("street has no buildings")
+	ErrNoBuildingsLeft      = errors.New("bank has no buildings left")
+	ErrCantBuild            = errors.New("player can't build or afford such a building")
+	ErrHousingShortage      = errors.New("buildings have to be auctioned during a housing shortage")
+	ErrNoHousingShortage    = errors.New("there is no housing shortage")
+	ErrNotCardHolder        = errors.New("player doesn't hold the card")
+	ErrNoJailCard           = errors.New("player has no Get Out of Jail Free card")
+	ErrNoDebts              = errors.New("player has no debts")
+	ErrDebtsPayable         = errors.New("player can raise enough money to pay their debts")
+	ErrBidTooLow            = errors.New("bid is lower than the minimum bid")
+	ErrAlreadyPassed        = errors.New("player already passed")
+	ErrHighestBidder        = errors.New("the highest bidder can't pass")
+	ErrUnknownPlayer        = errors.New("no player with this token")
+	ErrNothingToUndo        = errors.New("no action to undo")
+	ErrNothingToRedo        = errors.New("no action to redo")
+	ErrEmptyTrade           = errors.New("trade exchanges nothing")
+	ErrNotTradePartner      = errors.New("player is not the trade partner")
+	ErrDuplicateInTrade     = errors.New("trade lists a property or card more than once")
+	ErrInvalidRecipient     = errors.New("recipient is the giving player or bankrupt")
+	ErrNegativeAmount       = errors.New("amount is negative")
+)
+
+// Errors returned when loading a saved game or snapshot.
+var (
+	ErrUnsupportedVersion = errors.New("unsupported save version")
+	ErrInvalidSave        = errors.New("invalid save")
+	ErrInvalidSnapshot    = errors.New("invalid snapshot")
+)
+
+// Errors returned by a [Replay].
+var (
+	ErrReplayStart    = errors.New("replay is at the start")
+	ErrReplayEnd      = errors.New("replay is at the end")
+	ErrReplayDiverged = errors.New("replay diverged from the log")
+)
+
+// StateError is returned when an action is not allowed in the current [GameState].
+type StateError struct {
+	// Want are the states in which the action is allowed.
+	Want []GameState
+	// Got is the current state.
+	Got GameState
+}
+
+func (e *StateError) Error() string {
+	want := make([]string, 0, len(e.Want))
+	for _, gs := range e.Want {
+		want = append(want, gs.GoString())
+	}
+	return fmt.Sprintf("game is in state %#v, want %s", e.Got, strings.Join(want, " or "))
+}
+
+// InsufficientFundsError is returned when a player doesn't have enough money for an action.
+type InsufficientFundsError struct {
+	Required  int
+	Available int
+}
+
+func (e *InsufficientFundsError) Error() string {
+	return fmt.Sprintf("insufficient funds: %d required, %d available", e.Required, e.Available)
+}
+
+// checkState returns a [*StateError] if the game is not in one of the states want.
+func (g *Game) checkState(want ...GameState) error {
+	for _, gs := range want {
+		if g.state == gs {
+			return nil
+		}
+	}
+	return &StateError{Want: want, Got: g.state}
+}
+
+// checkAction returns a [*StateError] if the transition table doesn't allow actions of kind k in the
+// current state.
+func (g *Game) checkAction(k ActionKind) error {
+	return g.checkState(statesFor(k)...)
+}
+
+// checkTurn returns an error if the game is not in one of the states want or it's not p's turn.
+func (p *Player) checkTurn(want ...GameState) error {
+	if err := p.game.checkState(want...); err != nil {
+		return err
+	}
+	if p.game.players[p.game.currentTurn] != p {
+		return ErrNotYourTurn
+	}
+	return nil
+}
+
+// checkRecipient returns an error if p can't give anything to toPlayer for money.
+func (p *Player) checkRecipient(toPlayer *Player, money int) error {
+	if toPlayer == p || toPlayer.bankrupt {
+		return ErrInvalidRecipient
+	}
+	if money < 0 {
+		return ErrNegativeAmount
+	}
+	return toPlayer.checkFunds(money)
+}
+
+// checkFunds returns an [*InsufficientFundsError] if p has less than required money.
+func (p *Player) checkFunds(required int) error {
+	if p.money < required {
+		return &InsufficientFundsError{Required: required, Available: p.money}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package monopoly
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPlayer_errors(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG)
+	p, _ := g.GetCurrentPlayer()
+	other := g.players[1-g.currentTurn]
+
+	if _, _, _, err := other.RollDice(); err != ErrNotYourTurn {
+		t.Errorf("Player.RollDice() out of turn got error %v, want %v", err, ErrNotYourTurn)
+	}
+
+	var stateErr *StateError
+	if _, err := p.Move(); !errors.As(err, &stateErr) || stateErr.Got != GAME_TURN_START || stateErr.Want[0] != GAME_ROLLED_DICE {
+		t.Errorf("Player.Move() before rolling got error %v, want *StateError", err)
+	}
+
+	p.position = Field(BOARDWALK)
+	p.money = 100
+	g.state = GAME_BUY_DECISION
+	var fundsErr *InsufficientFundsError
+	if _, err := p.BuyProperty(); !errors.As(err, &fundsErr) || fundsErr.Required != 400 || fundsErr.Available != 100 {
+		t.Errorf("Player.BuyProperty() without enough money got error %v, want *InsufficientFundsError", err)
+	}
+
+	p.inventory[BOARDWALK] = STATE_MORTGAGE
+	if err := p.MortgageProperty(BOARDWALK); err != ErrPropertyMortgaged {
+		t.Errorf("Player.MortgageProperty() twice got error %v, want %v", err, ErrPropertyMortgaged)
+	}
+	if _, err := p.BuyHouse(BOARDWALK); err != ErrPropertyMortgaged {
+		t.Errorf("Player.BuyHouse() on a mortgaged street got error %v, want %v", err, ErrPropertyMortgaged)
+	}
+	if err := other.TransferProperty(p, BOARDWALK, 0); err != ErrNotOwner {
+		t.Errorf("Player.TransferProperty() of a foreign property got error %v, want %v", err, ErrNotOwner)
+	}
+}
+
+func TestPlayer_TransferProperty_recipient(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG, HAT)
+	p, _ := g.GetCurrentPlayer()
+	other := g.players[(g.currentTurn+1)%3]
+	bankrupt := g.players[(g.currentTurn+2)%3]
+	bankrupt.bankrupt = true
+	p.inventory[BOARDWALK] = STATE_MORTGAGE
+	g.jailCards[CHANCE_GET_OUT_OF_JAIL_FREE] = p
+	g.state = GAME_TURN
+
+	tests := []struct {
+		name  string
+		to    *Player
+		money int
+		want  error
+	}{
+		{"to themselves", p, 0, ErrInvalidRecipient},
+		{"to a bankrupt player", bankrupt, 0, ErrInvalidRecipient},
+		{"for a negative amount", other, -1400, ErrNegativeAmount},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := p.TransferProperty(tt.to, BOARDWALK, tt.money); err != tt.want {
+				t.Errorf("Player.TransferProperty() got error %v, want %v", err, tt.want)
+			}
+			if err := p.TransferJailCard(tt.to, CHANCE_GET_OUT_OF_JAIL_FREE, tt.money); err != tt.want {
+				t.Errorf("Player.TransferJailCard() got error %v, want %v", err, tt.want)
+			}
+			a := Action{Kind: ACTION_TRANSFER_PROPERTY, Player: p.token, Property: BOARDWALK, Target: tt.to.token, Amount: tt.money}
+			if _, err := g.Apply(a); err != tt.want {
+				t.Errorf("Game.Apply(TRANSFER_PROPERTY) got error %v, want %v", err, tt.want)
+			}
+			if p.inventory[BOARDWALK] != STATE_MORTGAGE || g.jailCards[CHANCE_GET_OUT_OF_JAIL_FREE] != p || p.money != 1500 {
+				t.Errorf("rejected transfer changed the giver to %#v, %v", p.inventory, p.money)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package monopoly
+
+import (
+	"fmt"
+
+	"github.com/Kesuaheli/monopoly/lang"
+	"golang.org/x/text/language"
+)
+
+// Event is something that happened in the game. Subscribers registered with [Game.Subscribe]
+// receive every event. Use a type switch to get the details of an event.
+type Event interface {
+	lang.Localizer
+	fmt.Stringer
+}
+
+// DiceRolledEvent is emitted when a player rolls the dice.
+type DiceRolledEvent struct {
+	Player *Player
+	D1, D2 int
+}
+
+// MovedEvent is emitted when a player moves to another field, either by the dice or by a card.
+type MovedEvent struct {
+	Player   *Player
+	From, To Field
+}
+
+// PassedGoEvent is emitted when a player crosses or lands on GO and collects the salary.
+type PassedGoEvent struct {
+	Player *Player
+	Salary int
+}
+
+// RentPaidEvent is emitted when a player has to pay rent for landing on a property of another
+// player. When the player can't afford the rent, the rest is noted as a debt.
+type RentPaidEvent struct {
+	Player   *Player
+	Owner    *Player
+	Property Property
+	Amount   int
+}
+
+// TaxPaidEvent is emitted when a player has to pay a tax for landing on a tax field.
+type TaxPaidEvent struct {
+	Player *Player
+	Field  Field
+	Amount int
+}
+
+// PropertyBoughtEvent is emitted when a player buys a property from the bank, either directly or
+// by winning an auction.
+type PropertyBoughtEvent struct {
+	Player   *Player
+	Property Property
+	Price    int
+}
+
+// WentToJailEvent is emitted when a player is sent to jail.
+type WentToJailEvent struct {
+	Player *Player
+}
+
+// CardDrawnEvent is emitted when a player draws a Chance or Community Chest card.
+type CardDrawnEvent struct {
+	Player *Player
+	Card   Card
+}
+
+// BankruptEvent is emitted when a player declares bankruptcy. The assets are split among all
+// creditors, Creditor is the one of the players first debt, who is paid first, nil if that is the
+// bank.
+type BankruptEvent struct {
+	Player   *Player
+	Creditor *Player
+}
+
+// TradeProposedEvent is emitted when a player proposes a trade to another player.
+type TradeProposedEvent struct {
+	From, To *Player
+}
+
+// TradeResolvedEvent is emitted when a proposed trade is accepted or rejected.
+type TradeResolvedEvent struct {
+	From, To *Player
+	Accepted bool
+}
+
+func (e DiceRolledEvent) String() string { return e.Localize(language.English) }
+
+// Localize returns a localized description of e in the language langTag.
+func (e DiceRolledEvent) Localize(langTag language.Tag) string {
+	return fmt.Sprintf(lang.MustLocalize("monopoly.event.dice_rolled", langTag), e.Player.token.Localize(langTag), e.D1, e.D2)
+}
+
+func (e MovedEvent) String() string { return e.Localize(language.English) }
+
+// Localize returns a localized description of e in the language langTag.
+func (e MovedEvent) Localize(langTag language.Tag) string {
+	return fmt.Sprintf(lang.MustLocalize("monopoly.event.moved", langTag),
+		e.Player.token.Localize(langTag),
+		e.Player.game.board.Localize(e.From, langTag),
+		e.Player.game.board.Localize(e.To, langTag),
+	)
+}
+
+func (e PassedGoEvent) String() string { return e.Localize(language.English) }
+
+// Localize returns a localized description of e in the language langTag.
+func (e PassedGoEvent) Localize(langTag language.Tag) string {
+	return fmt.Sprintf(lang.MustLocalize("monopoly.event.passed_go", langTag),
+		e.Player.token.Localize(langTag),
+		e.Player.game.board.Localize(GO, langTag),
+		formatCurrency(e.Salary, langTag),
+	)
+}
+
+func (e RentPaidEvent) String() string { return e.Localize(language.English) }
+
+// Localize returns a localized description of e in the language langTag.
+func (e RentPaidEvent) Localize(langTag language.Tag) string {
+	return fmt.Sprintf(lang.MustLocalize("monopoly.event.rent_paid", langTag),
+		e.Player.token.Localize(langTag),
+		e.Owner.token.Localize(langTag),
+		formatCurrency(e.Amount, langTag),
+		e.Player.game.board.Localize(Field(e.Property), langTag),
+	)
+}
+
+func (e TaxPaidEvent) String() string { return e.Localize(language.English) }
+
+// Localize returns a localized description of e in the language langTag.
+func (e TaxPaidEvent) Localize(langTag language.Tag) string {
+	return fmt.Sprintf(lang.MustLocalize("monopoly.event.tax_paid", langTag),
+		e.Player.token.Localize(langTag),
+		formatCurrency(e.Amount, langTag),
+		e.Player.game.board.Localize(e.Field, langTag),
+	)
+}
+
+func (e PropertyBoughtEvent) String() string { return e.Localize(language.English) }
+
+// Localize returns a localized description of e in the language langTag.
+func (e PropertyBoughtEvent) Localize(langTag language.Tag) string {
+	return fmt.Sprintf(lang.MustLocalize("monopoly.event.property_bought", langTag),
+		e.Player.token.Localize(langTag),
+		e.Player.game.board.Localize(Field(e.Property), langTag),
+		formatCurrency(e.Price, langTag),
+	)
+}
+
+func (e WentToJailEvent) String() string { return e.Localize(language.English) }
+
+// Localize returns a localized description of e in the language langTag.
+func (e WentToJailEvent) Localize(langTag language.Tag) string {
+	return fmt.Sprintf(lang.MustLocalize("monopoly.event.went_to_jail", langTag), e.Player.token.Localize(langTag))
+}
+
+func (e CardDrawnEvent) String() string { return e.Localize(language.English) }
+
+// Localize returns a localized description of e in the language langTag.
+func (e CardDrawnEvent) Localize(langTag language.Tag) string {
+	return fmt.Sprintf(lang.MustLocalize("monopoly.event.card_drawn", langTag), e.Player.token.Localize(langTag), e.Card.Localize(langTag))
+}
+
+func (e BankruptEvent) String() string { return e.Localize(language.English) }
+
+// Localize returns a localized description of e in the language langTag.
+func (e BankruptEvent) Localize(langTag language.Tag) string {
+	if e.Creditor == nil {
+		return fmt.Sprintf(lang.MustLocalize("monopoly.event.bankrupt_bank", langTag), e.Player.token.Localize(langTag))
+	}
+	return fmt.Sprintf(lang.MustLocalize("monopoly.event.bankrupt", langTag), e.Player.token.Localize(langTag), e.Creditor.token.Localize(langTag))
+}
+
+func (e TradeProposedEvent) String() string { return e.Localize(language.English) }
+
+// Localize returns a localized description of e in the language langTag.
+func (e TradeProposedEvent) Localize(langTag language.Tag) string {
+	return fmt.Sprintf(lang.MustLocalize("monopoly.event.trade_proposed", langTag), e.From.token.Localize(langTag), e.To.token.Localize(langTag))
+}
+
+func (e TradeResolvedEvent) String() string { return e.Localize(language.English) }
+
+// Localize returns a localized description of e in the language langTag.
+func (e TradeResolvedEvent) Localize(langTag language.Tag) string {
+	if e.Accepted {
+		return fmt.Sprintf(lang.MustLocalize("monopoly.event.trade_accepted", langTag), e.To.token.Localize(langTag), e.From.token.Localize(langTag))
+	}
+	return fmt.Sprintf(lang.MustLocalize("monopoly.event.trade_rejected", langTag), e.From.token.Localize(langTag), e.To.token.Localize(langTag))
+}
+
+// Subscribe registers fn to receive every event of g. The events of an action are delivered in the
+// order they happen, before the action returns. fn is called after g is unlocked again, so it may
+// call methods of g. The returned function removes the subscription again.
+func (g *Game) Subscribe(fn func(Event)) (unsubscribe func()) {
+	g.lock()
+	defer g.unlock()
+	g.subscribers = append(g.subscribers, fn)
+	i := len(g.subscribers) - 1
+	return func() {
+		g.lock()
+		defer g.unlock()
+		g.subscribers[i] = nil
+	}
+}
+
+// emit queues e for delivery to all subscribers of g, once g is unlocked.
+func (g *Game) emit(e Event) {
+	g.events = append(g.events, e)
+}
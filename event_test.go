@@ -0,0 +1,71 @@
+package monopoly
+
+import "testing"
+
+func TestGame_Subscribe(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG)
+	p, _ := g.GetCurrentPlayer()
+	owner := g.players[1-g.currentTurn]
+	owner.inventory[BOARDWALK] = STATE_NORMAL
+
+	var events []Event
+	unsubscribe := g.Subscribe(func(e Event) {
+		events = append(events, e)
+	})
+
+	p.position = Field(PARK_PLACE)
+	g.setLastRoll(1, 1)
+	g.state = GAME_ROLLED_DICE
+	if _, err := p.Move(); err != nil {
+		t.Fatalf("Player.Move() got error: %v", err)
+	}
+	g.lock()
+	p.advanceTo(GO)
+	g.unlock()
+
+	want := []Event{
+		MovedEvent{Player: p, From: Field(PARK_PLACE), To: Field(BOARDWALK)},
+		RentPaidEvent{Player: p, Owner: owner, Property: BOARDWALK, Amount: 50},
+		MovedEvent{Player: p, From: Field(BOARDWALK), To: GO},
+		PassedGoEvent{Player: p, Salary: defaultRules.MoneyOnGo},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("got %d events %v, want %d", len(events), events, len(want))
+	}
+	for i := range want {
+		if events[i] != want[i] {
+			t.Errorf("event %d got %v, want %v", i, events[i], want[i])
+		}
+	}
+
+	unsubscribe()
+	g.lock()
+	p.goToJail()
+	g.unlock()
+	if len(events) != len(want) {
+		t.Errorf("got event %v after unsubscribing", events[len(events)-1])
+	}
+}
+
+func TestGame_Subscribe_noRent(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG)
+	p, _ := g.GetCurrentPlayer()
+	owner := g.players[1-g.currentTurn]
+	owner.inventory[BOARDWALK] = STATE_MORTGAGE
+
+	var events []Event
+	g.Subscribe(func(e Event) {
+		if _, ok := e.(RentPaidEvent); ok {
+			events = append(events, e)
+		}
+	})
+	p.position = Field(PARK_PLACE)
+	g.setLastRoll(1, 1)
+	g.state = GAME_ROLLED_DICE
+	if _, err := p.Move(); err != nil {
+		t.Fatalf("Player.Move() got error: %v", err)
+	}
+	if len(events) != 0 || p.money != defaultRules.StartMoney {
+		t.Errorf("landing on a mortgaged property got events %v and %d money", events, p.money)
+	}
+}
@@ -41,7 +41,7 @@ func (f Field) Localize(langTag language.Tag) string {
 	case CHANCE_3:
 		return lang.MustLocalize("monopoly.field.chance_3", langTag)
 	case LUXERY_TAX:
-		return lang.MustLocalize("monopoly.field.luxery_tax", langTag)
+		return lang.MustLocalize("monopoly.field.luxury_tax", langTag)
 	case IN_JAIL:
 		return lang.MustLocalize("monopoly.field.in_jail", langTag)
 	default:
@@ -2,70 +2,229 @@ package monopoly
 
 import (
 	"fmt"
-	"math/rand"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/Kesuaheli/monopoly/lang"
 	"golang.org/x/text/language"
 )
 
-// Game represents a game of Monopoly.
+// Game represents a game of Monopoly. A Game and its players are safe for concurrent use by multiple
+// goroutines.
 type Game struct {
+	// mu guards the game and all of its players. Every exported method of [Game] and [Player] locks it
+	// once with [Game.lock], all unexported methods expect it to be held already.
+	mu sync.Mutex
+
 	// Language is the language used when printing names and messages
 	Language language.Tag
 
+	rules       Rules
+	board       *Board
+	random      RandomSource
 	players     []*Player
 	currentTurn int
 
 	lastRoll      uint8 // 2 dice encoded in 2 blocks of 4 bit
 	doubblesCount int
 	state         GameState
+	resumeState   GameState // the state to return to after all debts are resolved
+
+	chance         Deck
+	communityChest Deck
+	drawnCards     []Card
+	jailCards      map[Card]*Player // Get Out of Jail Free cards currently held by players
+
+	auction *Auction
+	trade   *TradeProposal
+	houses  int // houses the bank has left
+	hotels  int // hotels the bank has left
+	jackpot int // money collected for the Free Parking jackpot
+
+	subscribers []func(Event)
+	events      []Event // emitted while locked, delivered by unlock
+	log         *ActionLog
+	undo        []undoState
+	redo        []Action
+	redoing     bool // whether an undone action is applied again
 }
 
-// NewGame creates a new game of Monopoly and initializes it with the default state.
-func NewGame(players ...Token) *Game {
+// NewGame creates a new game of Monopoly played with rules and initializes it with the default
+// state.
+func NewGame(rules Rules, players ...Token) *Game {
 	if len(players) < 2 {
 		return nil
 	}
 
+	if rules.Board == nil {
+		rules.Board = DefaultBoard()
+	}
+	if rules.Random == nil {
+		rules.Random = NewSeededSource(time.Now().UnixNano())
+	}
+
 	g := &Game{
-		players:     make([]*Player, 0, len(players)),
-		currentTurn: rand.Intn(len(players)),
+		rules:     rules,
+		board:     rules.Board,
+		random:    rules.Random,
+		players:   make([]*Player, 0, len(players)),
+		jailCards: map[Card]*Player{},
+		houses:    rules.Houses,
+		hotels:    rules.Hotels,
+		log: &ActionLog{
+			Rules:   rules,
+			Players: append([]Token(nil), players...),
+		},
 	}
+	g.log.Rules.Random = nil
+	g.currentTurn = g.rng().Intn(len(players))
+	g.chance = newDeck(allChanceCards(), g.rng())
+	g.communityChest = newDeck(allCommunityChestCards(), g.rng())
 	for _, t := range players {
 		g.players = append(g.players, InitPlayer(g, t))
 	}
 	return g
 }
 
-func (g Game) String() string {
+// lock locks g. It is called once by every exported method of [Game] and [Player].
+func (g *Game) lock() {
+	g.mu.Lock()
+}
+
+// unlock unlocks g and delivers all events emitted while g was locked afterwards, so subscribers may
+// call methods of g.
+func (g *Game) unlock() {
+	events := g.events
+	g.events = nil
+	var subscribers []func(Event)
+	if len(events) != 0 {
+		subscribers = append(subscribers, g.subscribers...)
+	}
+	g.mu.Unlock()
+
+	for _, e := range events {
+		for _, fn := range subscribers {
+			if fn != nil {
+				fn(e)
+			}
+		}
+	}
+}
+
+// copyState copies the state of o into g. The lock, the subscribers, the log and the undo history of
+// g are kept.
+func (g *Game) copyState(o *Game) {
+	g.Language = o.Language
+	g.rules = o.rules
+	g.board = o.board
+	g.random = o.random
+	g.players = o.players
+	g.currentTurn = o.currentTurn
+	g.lastRoll = o.lastRoll
+	g.doubblesCount = o.doubblesCount
+	g.state = o.state
+	g.resumeState = o.resumeState
+	g.chance = o.chance
+	g.communityChest = o.communityChest
+	g.drawnCards = o.drawnCards
+	g.jailCards = o.jailCards
+	g.auction = o.auction
+	g.trade = o.trade
+	g.houses = o.houses
+	g.hotels = o.hotels
+	g.jackpot = o.jackpot
+}
+
+func (g *Game) String() string {
+	g.lock()
+	defer g.unlock()
 	var players []string
 	for _, player := range g.players {
-		players = append(players, player.String())
+		players = append(players, player.string())
 	}
 	return fmt.Sprintf("%d players\n- %s", len(g.players), strings.Join(players, "\n- "))
 }
 
-func (g Game) GoString() string {
+func (g *Game) GoString() string {
+	g.lock()
+	defer g.unlock()
 	var players []string
 	for _, player := range g.players {
-		players = append(players, player.GoString())
+		players = append(players, player.goString())
 	}
 	return "{players: " + strings.Join(players, ", ") + "}"
 }
 
 // FormatCurrency is a helper function to print the given amount of money with the currency symbol
 // for the selected language.
-func (g Game) FormatCurrency(a int) string {
-	return fmt.Sprintf(lang.MustLocalize("monopoly.currency", g.Language), a)
+func (g *Game) FormatCurrency(a int) string {
+	g.lock()
+	defer g.unlock()
+	return formatCurrency(a, g.Language)
+}
+
+// formatCurrency formats the amount of money a with the currency symbol for the language langTag.
+func formatCurrency(a int, langTag language.Tag) string {
+	return fmt.Sprintf(lang.MustLocalize("monopoly.currency", langTag), a)
+}
+
+// Rules returns the rules the game is played with.
+func (g *Game) Rules() Rules {
+	g.lock()
+	defer g.unlock()
+	return g.rules
+}
+
+// Board returns the board the game is played on.
+func (g *Game) Board() *Board {
+	g.lock()
+	defer g.unlock()
+	return g.board
+}
+
+// Seed returns the seed of the games random source and reports whether the game uses a
+// [SeededSource] at all. Playing with the same seed, players and actions reproduces the game.
+func (g *Game) Seed() (int64, bool) {
+	g.lock()
+	defer g.unlock()
+	s, ok := g.random.(*SeededSource)
+	if !ok {
+		return 0, false
+	}
+	return s.Seed(), true
+}
+
+// Jackpot returns the amount of money currently collected in the Free Parking jackpot. It is always
+// 0 without the [FREE_PARKING_JACKPOT] house rule.
+func (g *Game) Jackpot() int {
+	g.lock()
+	defer g.unlock()
+	return g.jackpot
 }
 
 // SetLanguage sets the language used when printing names and messages
 func (g *Game) SetLanguage(langTag language.Tag) {
+	g.lock()
+	defer g.unlock()
 	g.Language = langTag
 }
 
-func (g Game) GetPlayer(t Token) *Player {
+// Players returns all players of the game in turn order, including bankrupt ones.
+func (g *Game) Players() []*Player {
+	g.lock()
+	defer g.unlock()
+	return append([]*Player(nil), g.players...)
+}
+
+func (g *Game) GetPlayer(t Token) *Player {
+	g.lock()
+	defer g.unlock()
+	return g.player(t)
+}
+
+// player is like GetPlayer, but expects g to be locked.
+func (g *Game) player(t Token) *Player {
 	for _, player := range g.players {
 		if player.token == t {
 			return player
@@ -74,31 +233,72 @@ func (g Game) GetPlayer(t Token) *Player {
 	return nil
 }
 
-func (g Game) GetCurrentPlayer() (*Player, GameState) {
+func (g *Game) GetCurrentPlayer() (*Player, GameState) {
+	g.lock()
+	defer g.unlock()
 	return g.players[g.currentTurn], g.state
 }
 
-func (g Game) GetPlayerForProperty(prop Property) (*Player, PropertyState, bool) {
+func (g *Game) GetPlayerForProperty(prop Property) (*Player, PropertyState, bool) {
+	g.lock()
+	defer g.unlock()
+	return g.playerForProperty(prop)
+}
+
+// playerForProperty is like GetPlayerForProperty, but expects g to be locked.
+func (g *Game) playerForProperty(prop Property) (*Player, PropertyState, bool) {
 	for _, player := range g.players {
-		player.invLock.Lock()
-		defer player.invLock.Unlock()
-		for invProp := range player.inventory {
-			if invProp == prop {
-				return player, player.inventory[prop], true
-			}
+		if state, ok := player.inventory[prop]; ok {
+			return player, state, true
 		}
 	}
 	return nil, -1, false
 }
 
-func (g Game) IsPropertyAvailable(prop Property) bool {
-	_, _, isSold := g.GetPlayerForProperty(prop)
+// DrawnCards returns all cards drawn by the current player during their last move, in the order
+// they were drawn.
+func (g *Game) DrawnCards() []Card {
+	g.lock()
+	defer g.unlock()
+	return append([]Card(nil), g.drawnCards...)
+}
+
+// LastCard returns the card drawn last by the current player during their last move and reports
+// whether a card was drawn at all.
+func (g *Game) LastCard() (Card, bool) {
+	g.lock()
+	defer g.unlock()
+	if len(g.drawnCards) == 0 {
+		return -1, false
+	}
+	return g.drawnCards[len(g.drawnCards)-1], true
+}
+
+// GetPlayerForCard returns the player currently holding the Get Out of Jail Free card c and reports
+// whether any player holds it.
+func (g *Game) GetPlayerForCard(c Card) (*Player, bool) {
+	g.lock()
+	defer g.unlock()
+	p, ok := g.jailCards[c]
+	return p, ok
+}
+
+func (g *Game) IsPropertyAvailable(prop Property) bool {
+	g.lock()
+	defer g.unlock()
+	return g.isPropertyAvailable(prop)
+}
+
+// isPropertyAvailable is like IsPropertyAvailable, but expects g to be locked.
+func (g *Game) isPropertyAvailable(prop Property) bool {
+	_, _, isSold := g.playerForProperty(prop)
 	return !isSold
 }
 
 func (g *Game) rollDice() (int, int) {
-	d1, d2 := RollDice()
+	d1, d2 := RollDice(g.rng())
 	g.lastRoll = uint8(d1<<4 | d2&(1<<4-1))
+	g.emit(DiceRolledEvent{Player: g.players[g.currentTurn], D1: d1, D2: d2})
 	return d1, d2
 }
 
@@ -106,17 +306,33 @@ func (g *Game) setLastRoll(d1 int, d2 int) {
 	g.lastRoll = uint8(d1<<4 | d2&(1<<4-1))
 }
 
-func (g Game) getLastRoll() (int, int) {
+func (g *Game) getLastRoll() (int, int) {
 	return int(g.lastRoll >> 4), int(g.lastRoll & (1<<4 - 1))
 }
 
-func RollDice() (int, int) {
-	return rand.Intn(6) + 1, rand.Intn(6) + 1
+// RollDice rolls two dice using r.
+func RollDice(r RandomSource) (int, int) {
+	return r.Intn(6) + 1, r.Intn(6) + 1
 }
 
 func (g *Game) nextPlayer() {
-	g.currentTurn++
-	if g.currentTurn >= len(g.players) {
-		g.currentTurn = 0
+	for {
+		g.currentTurn++
+		if g.currentTurn >= len(g.players) {
+			g.currentTurn = 0
+		}
+		if !g.players[g.currentTurn].bankrupt {
+			return
+		}
+	}
+}
+
+// beginTurn sets the state for the start of the current players turn. A player who sits in jail has
+// to decide how to get out first.
+func (g *Game) beginTurn() {
+	if g.players[g.currentTurn].position == IN_JAIL {
+		g.state = GAME_IN_JAIL
+	} else {
+		g.state = GAME_TURN_START
 	}
 }
@@ -7,6 +7,13 @@ const (
 	GAME_ROLLED_DICE
 	GAME_MOVED_TO_NEW_FIELD
 	GAME_TURN
+	GAME_IN_JAIL
+	GAME_DEBT
+	GAME_OVER
+	GAME_AUCTION
+	GAME_BUY_DECISION // the current player has to buy or decline the property they landed on
+	GAME_CARD         // the current player has to resolve the card they drew
+	GAME_TRADE        // the other player has to accept or reject the trade the current player proposed
 )
 
 func (gs GameState) String() string {
@@ -19,6 +26,20 @@ func (gs GameState) String() string {
 		return "moved to new field"
 	case GAME_TURN:
 		return "normal turn"
+	case GAME_IN_JAIL:
+		return "in jail"
+	case GAME_DEBT:
+		return "resolving debts"
+	case GAME_OVER:
+		return "game over"
+	case GAME_AUCTION:
+		return "auction"
+	case GAME_BUY_DECISION:
+		return "buy decision"
+	case GAME_CARD:
+		return "card resolution"
+	case GAME_TRADE:
+		return "trade pending"
 	default:
 		return "UNKNOWN"
 	}
@@ -34,7 +55,116 @@ func (gs GameState) GoString() string {
 		return "GAME_MOVED_TO_NEW_FIELD"
 	case GAME_TURN:
 		return "GAME_TURN"
+	case GAME_IN_JAIL:
+		return "GAME_IN_JAIL"
+	case GAME_DEBT:
+		return "GAME_DEBT"
+	case GAME_OVER:
+		return "GAME_OVER"
+	case GAME_AUCTION:
+		return "GAME_AUCTION"
+	case GAME_BUY_DECISION:
+		return "GAME_BUY_DECISION"
+	case GAME_CARD:
+		return "GAME_CARD"
+	case GAME_TRADE:
+		return "GAME_TRADE"
 	default:
 		return "UNKNOWN"
 	}
 }
+
+// allGameStates returns a slice of all game states.
+func allGameStates() []GameState {
+	return []GameState{
+		GAME_TURN_START,
+		GAME_ROLLED_DICE,
+		GAME_MOVED_TO_NEW_FIELD,
+		GAME_TURN,
+		GAME_IN_JAIL,
+		GAME_DEBT,
+		GAME_OVER,
+		GAME_AUCTION,
+		GAME_BUY_DECISION,
+		GAME_CARD,
+		GAME_TRADE,
+	}
+}
+
+// Transition is a change of the game state an action can cause. Which of the states To the game
+// ends up in depends on the outcome of the action, e.g. the dice.
+type Transition struct {
+	From   GameState
+	Action ActionKind
+	To     []GameState
+}
+
+var (
+	// activeStates are the states during a players turn in which players may manage their
+	// properties.
+	activeStates = []GameState{GAME_TURN_START, GAME_IN_JAIL, GAME_ROLLED_DICE, GAME_MOVED_TO_NEW_FIELD, GAME_BUY_DECISION, GAME_TURN}
+	// resumeStates are the states a debt resolution can return to.
+	resumeStates = []GameState{GAME_TURN_START, GAME_IN_JAIL, GAME_ROLLED_DICE, GAME_MOVED_TO_NEW_FIELD, GAME_BUY_DECISION, GAME_CARD}
+)
+
+// transitions is the transition table of the game, see [Transitions].
+var transitions = func() []Transition {
+	t := []Transition{
+		{GAME_TURN_START, ACTION_ROLL_DICE, []GameState{GAME_ROLLED_DICE}},
+		{GAME_IN_JAIL, ACTION_ROLL_DICE, []GameState{GAME_ROLLED_DICE, GAME_TURN, GAME_DEBT}},
+		{GAME_IN_JAIL, ACTION_PAY_JAIL_FINE, []GameState{GAME_TURN_START}},
+		{GAME_IN_JAIL, ACTION_USE_JAIL_CARD, []GameState{GAME_TURN_START}},
+		{GAME_ROLLED_DICE, ACTION_MOVE, []GameState{GAME_MOVED_TO_NEW_FIELD, GAME_BUY_DECISION, GAME_CARD, GAME_DEBT}},
+		{GAME_MOVED_TO_NEW_FIELD, ACTION_CONTINUE, []GameState{GAME_TURN}},
+		{GAME_CARD, ACTION_CONTINUE, []GameState{GAME_MOVED_TO_NEW_FIELD, GAME_BUY_DECISION, GAME_CARD, GAME_DEBT}},
+		{GAME_BUY_DECISION, ACTION_BUY_PROPERTY, []GameState{GAME_TURN}},
+		{GAME_BUY_DECISION, ACTION_DECLINE_PROPERTY, []GameState{GAME_AUCTION, GAME_TURN}},
+		{GAME_AUCTION, ACTION_BID, append([]GameState{GAME_AUCTION, GAME_DEBT}, activeStates...)},
+		{GAME_AUCTION, ACTION_PASS_AUCTION, append([]GameState{GAME_AUCTION, GAME_DEBT}, activeStates...)},
+		{GAME_DEBT, ACTION_PAY_DEBTS, append([]GameState{GAME_DEBT}, resumeStates...)},
+		{GAME_DEBT, ACTION_DECLARE_BANKRUPTCY, append([]GameState{GAME_DEBT, GAME_OVER}, resumeStates...)},
+		{GAME_TURN, ACTION_PROPOSE_TRADE, []GameState{GAME_TRADE}},
+		{GAME_TRADE, ACTION_ACCEPT_TRADE, []GameState{GAME_TURN}},
+		{GAME_TRADE, ACTION_REJECT_TRADE, []GameState{GAME_TURN}},
+		{GAME_TURN, ACTION_END_TURN, []GameState{GAME_TURN_START, GAME_IN_JAIL}},
+	}
+	loops := map[ActionKind][]GameState{
+		ACTION_MORTGAGE_PROPERTY:        append(activeStates, GAME_DEBT, GAME_AUCTION),
+		ACTION_CANCEL_MORTGAGE_PROPERTY: append(activeStates, GAME_DEBT, GAME_AUCTION),
+		ACTION_SELL_HOUSE:               append(activeStates, GAME_DEBT, GAME_AUCTION),
+		ACTION_BUY_HOUSE:                activeStates,
+		ACTION_TRANSFER_PROPERTY:        append(activeStates, GAME_DEBT),
+		ACTION_TRANSFER_JAIL_CARD:       append(activeStates, GAME_DEBT),
+	}
+	for _, k := range allActionKinds() {
+		for _, from := range loops[k] {
+			t = append(t, Transition{from, k, []GameState{from}})
+		}
+	}
+	for _, from := range activeStates {
+		t = append(t, Transition{from, ACTION_AUCTION_BUILDING, []GameState{GAME_AUCTION}})
+	}
+	return t
+}()
+
+// Transitions returns the transition table of the game. For every state it lists the actions that
+// can be taken in it and the states they can lead to. Actions not listed for the current state are
+// rejected with a [*StateError], both by [Game.Apply] and by the methods of [Player].
+func Transitions() []Transition {
+	t := make([]Transition, len(transitions))
+	for i, tr := range transitions {
+		t[i] = Transition{tr.From, tr.Action, append([]GameState(nil), tr.To...)}
+	}
+	return t
+}
+
+// statesFor returns all states in which an action of kind k can be taken.
+func statesFor(k ActionKind) []GameState {
+	var states []GameState
+	for _, tr := range transitions {
+		if tr.Action == k {
+			states = append(states, tr.From)
+		}
+	}
+	return states
+}
@@ -0,0 +1,115 @@
+package monopoly
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestTransitions_stateErrors(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG)
+	p, _ := g.GetCurrentPlayer()
+	for _, k := range allActionKinds() {
+		states := statesFor(k)
+		if len(states) == 0 {
+			t.Errorf("%#v has no transition", k)
+		}
+		for _, s := range allGameStates() {
+			if slices.Contains(states, s) {
+				continue
+			}
+			g.state = s
+			var stateErr *StateError
+			if err := g.validate(Action{Kind: k, Player: p.token}); !errors.As(err, &stateErr) {
+				t.Errorf("Game.validate(%#v) in state %#v got error %v, want *StateError", k, s, err)
+			}
+		}
+	}
+}
+
+// TestTransitions_random plays random legal actions and checks that every change of the game
+// state is listed in the transition table.
+func TestTransitions_random(t *testing.T) {
+	allowed := map[[2]GameState]map[ActionKind]bool{}
+	for _, tr := range Transitions() {
+		for _, to := range tr.To {
+			key := [2]GameState{tr.From, to}
+			if allowed[key] == nil {
+				allowed[key] = map[ActionKind]bool{}
+			}
+			allowed[key][tr.Action] = true
+		}
+	}
+
+	for seed := int64(1); seed <= 5; seed++ {
+		rules := DefaultRules()
+		rules.Random = NewSeededSource(seed)
+		g := NewGame(rules, CAR, DOG, HAT)
+		r := rand.New(rand.NewSource(seed))
+
+		for i := 0; i < 1000; i++ {
+			var legal []Action
+			for _, p := range g.Players() {
+				legal = append(legal, g.LegalActions(p.token)...)
+			}
+			if len(legal) == 0 {
+				break
+			}
+			a := legal[r.Intn(len(legal))]
+			if a.Kind == ACTION_TRANSFER_PROPERTY || a.Kind == ACTION_TRANSFER_JAIL_CARD {
+				continue
+			}
+			if a.Kind == ACTION_PROPOSE_TRADE {
+				for _, p := range g.Players() {
+					if p.token != a.Player && !p.Bankrupt() {
+						a.Target = p.token
+					}
+				}
+				a.Trade = &Trade{Money: 1}
+				if g.CanApply(a) != nil {
+					continue
+				}
+			}
+			_, from := g.GetCurrentPlayer()
+			if _, err := g.Apply(a); err != nil {
+				t.Fatalf("Game.Apply(%#v) in state %#v got error: %v", a, from, err)
+			}
+			if _, to := g.GetCurrentPlayer(); !allowed[[2]GameState{from, to}][a.Kind] {
+				t.Fatalf("%#v changed the state from %#v to %#v, which is not in the transition table", a.Kind, from, to)
+			}
+		}
+	}
+}
+
+func TestPlayer_actions_transitionTable(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG)
+	p, _ := g.GetCurrentPlayer()
+	other := g.players[1-g.currentTurn]
+	p.inventory[MEDITERRANEAN_AVENUE] = STATE_HOUSE_1
+	p.inventory[BALTIC_AVENUE] = STATE_HOUSE_1
+	p.inventory[BOARDWALK] = STATE_NORMAL
+	p.inventory[PARK_PLACE] = STATE_MORTGAGE
+	g.jailCards[CHANCE_GET_OUT_OF_JAIL_FREE] = p
+
+	actions := map[ActionKind]func() error{
+		ACTION_MORTGAGE_PROPERTY:        func() error { return p.MortgageProperty(BOARDWALK) },
+		ACTION_CANCEL_MORTGAGE_PROPERTY: func() error { return p.CancelMortgageProperty(PARK_PLACE) },
+		ACTION_BUY_HOUSE:                func() error { _, err := p.BuyHouse(MEDITERRANEAN_AVENUE); return err },
+		ACTION_SELL_HOUSE:               func() error { _, err := p.SellHouse(MEDITERRANEAN_AVENUE); return err },
+		ACTION_TRANSFER_PROPERTY:        func() error { return p.TransferProperty(other, BOARDWALK, 0) },
+		ACTION_TRANSFER_JAIL_CARD:       func() error { return p.TransferJailCard(other, CHANCE_GET_OUT_OF_JAIL_FREE, 0) },
+	}
+	for _, state := range allGameStates() {
+		for kind, action := range actions {
+			if slices.Contains(statesFor(kind), state) {
+				continue
+			}
+			g.state = state
+			var stateErr *StateError
+			if err := action(); !errors.As(err, &stateErr) {
+				t.Errorf("%#v in state %#v got error %v, want *StateError", kind, state, err)
+			}
+		}
+	}
+}
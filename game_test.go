@@ -1,10 +1,16 @@
 package monopoly
 
 import (
+	"io"
+	"runtime"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
 )
 
+var defaultRules = DefaultRules()
+
 func TestGame_setLastRoll(t *testing.T) {
 	g := &Game{}
 	for d1 := 1; d1 <= 6; d1++ {
@@ -20,8 +26,9 @@ func TestGame_setLastRoll(t *testing.T) {
 
 func TestRollDice(t *testing.T) {
 	const testCount = 50
+	r := NewSeededSource(testCount)
 	for n := 0; n < testCount; n++ {
-		d1, d2 := RollDice()
+		d1, d2 := RollDice(r)
 		if d1 < 1 {
 			t.Errorf("RollDice() got = (%d, %d), d1 is smaller than 1", d1, d2)
 		}
@@ -36,3 +43,139 @@ func TestRollDice(t *testing.T) {
 		}
 	}
 }
+
+func TestPlayer_PayJailFine(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG)
+	p, _ := g.GetCurrentPlayer()
+	p.goToJail()
+	g.beginTurn()
+
+	if _, state := g.GetCurrentPlayer(); state != GAME_IN_JAIL {
+		t.Fatalf("Game.GetCurrentPlayer() got state %#v, want %#v", state, GAME_IN_JAIL)
+	}
+	if err := p.PayJailFine(); err != nil {
+		t.Fatalf("Player.PayJailFine() got error: %v", err)
+	}
+	if p.position != JUST_VISITING || p.money != defaultRules.StartMoney-defaultRules.JailFine {
+		t.Errorf("Player after paying fine is on %#v with %d money, want %#v with %d", p.position, p.money, JUST_VISITING, defaultRules.StartMoney-defaultRules.JailFine)
+	}
+	if _, state := g.GetCurrentPlayer(); state != GAME_TURN_START {
+		t.Errorf("Game.GetCurrentPlayer() got state %#v, want %#v", state, GAME_TURN_START)
+	}
+}
+
+func TestPlayer_RollDice_inJail(t *testing.T) {
+	tests := []struct {
+		name      string
+		d1, d2    int
+		wantMoney int
+	}{
+		{"doubles", 4, 4, defaultRules.StartMoney},
+		{"no doubles", 3, 4, defaultRules.StartMoney - defaultRules.JailFine},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGame(DefaultRules(), CAR, DOG)
+			g.random = NewFixedDice(tt.d1, tt.d2)
+			p, _ := g.GetCurrentPlayer()
+			p.goToJail()
+			p.roundsInJail = defaultRules.MaxRoundsInJail - 1
+			g.beginTurn()
+
+			d1, d2, _, err := p.RollDice()
+			if err != nil {
+				t.Fatalf("Player.RollDice() in jail got error: %v", err)
+			}
+			if d1 != tt.d1 || d2 != tt.d2 {
+				t.Fatalf("Player.RollDice() got [%d] [%d], want [%d] [%d]", d1, d2, tt.d1, tt.d2)
+			}
+			if p.InJail() || p.money != tt.wantMoney {
+				t.Errorf("Player after rolling [%d] [%d] in last jail round is on %#v with %d money, want %#v with %d", d1, d2, p.position, p.money, JUST_VISITING, tt.wantMoney)
+			}
+			if g.state != GAME_ROLLED_DICE {
+				t.Errorf("Game state after rolling in last jail round got %#v, want %#v", g.state, GAME_ROLLED_DICE)
+			}
+		})
+	}
+}
+
+// TestGame_concurrent lets every player act from its own goroutine while another goroutine reads
+// the game. Run it with -race to detect unguarded state.
+func TestGame_concurrent(t *testing.T) {
+	rules := DefaultRules()
+	rules.Random = NewSeededSource(1)
+	g := NewGame(rules, CAR, DOG, HAT, IRON)
+
+	var events atomic.Int64
+	g.Subscribe(func(e Event) {
+		g.Players() // subscribers may call back into the game
+		events.Add(1)
+	})
+
+	var wg sync.WaitGroup
+	for _, p := range g.Players() {
+		wg.Add(1)
+		go func(p *Player) {
+			defer wg.Done()
+			for i := 0; i < 2000; i++ {
+				curr, state := g.GetCurrentPlayer()
+				switch {
+				case state == GAME_OVER:
+					return
+				case state == GAME_AUCTION:
+					if a, ok := g.Auction(); ok {
+						if _, highest := a.HighestBid(); highest == nil {
+							p.Bid(a.MinimumBid())
+						} else {
+							p.PassAuction()
+						}
+					}
+				case state == GAME_DEBT:
+					if p.Debt() > 0 && p.PayDebts() != nil {
+						p.DeclareBankruptcy()
+					}
+				case curr != p:
+					runtime.Gosched()
+				case state == GAME_TURN_START || state == GAME_IN_JAIL:
+					p.RollDice()
+				case state == GAME_ROLLED_DICE:
+					p.Move()
+				case state == GAME_MOVED_TO_NEW_FIELD || state == GAME_CARD:
+					p.Continue()
+				case state == GAME_BUY_DECISION:
+					if _, err := p.BuyProperty(); err != nil {
+						p.DeclineProperty()
+					}
+				case state == GAME_TURN:
+					p.EndTurn()
+				}
+			}
+		}(p)
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 200; i++ {
+			_ = g.String()
+			g.GetPlayerForProperty(BOARDWALK)
+			if err := g.Save(io.Discard); err != nil {
+				t.Errorf("Game.Save() got error: %v", err)
+				return
+			}
+		}
+	}()
+	wg.Wait()
+
+	if events.Load() == 0 {
+		t.Error("no events were delivered")
+	}
+	owned := map[Property]Token{}
+	for _, p := range g.Players() {
+		for prop := range p.inventory {
+			if other, ok := owned[prop]; ok {
+				t.Errorf("%#v is owned by %#v and %#v", prop, other, p.token)
+			}
+			owned[prop] = p.token
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package monopoly
+
+import (
+	"strings"
+
+	"github.com/Kesuaheli/monopoly/lang"
+	"golang.org/x/text/language"
+)
+
+// HouseRule is an optional rule that is not part of the official rules, but commonly played at
+// casual tables. House rules are flags and can be combined with a bitwise or.
+type HouseRule uint8
+
+const (
+	// FREE_PARKING_JACKPOT collects all taxes and card fees in a pot, which the next player landing on
+	// Free Parking receives.
+	FREE_PARKING_JACKPOT HouseRule = 1 << iota
+	// DOUBLE_SALARY_ON_GO doubles the salary for landing exactly on GO.
+	DOUBLE_SALARY_ON_GO
+	// NO_RENT_IN_JAIL prevents players from collecting rent while they are in jail.
+	NO_RENT_IN_JAIL
+	// NO_BUYING_FIRST_LAP prevents players from buying properties before they crossed GO once.
+	NO_BUYING_FIRST_LAP
+)
+
+// AllHouseRules returns a slice of all available house rules.
+func AllHouseRules() []HouseRule {
+	return []HouseRule{
+		FREE_PARKING_JACKPOT,
+		DOUBLE_SALARY_ON_GO,
+		NO_RENT_IN_JAIL,
+		NO_BUYING_FIRST_LAP,
+	}
+}
+
+// Has reports whether all house rules in rule are enabled in hr.
+func (hr HouseRule) Has(rule HouseRule) bool {
+	return hr&rule == rule
+}
+
+// String returns the english name for hr.
+// String implements [fmt.Stringer] interface.
+func (hr HouseRule) String() string {
+	return hr.Localize(language.English)
+}
+
+// Localize returns the localized name for hr in the language langTag. A combination of house rules
+// is returned as a comma separated list.
+func (hr HouseRule) Localize(langTag language.Tag) string {
+	switch hr {
+	case FREE_PARKING_JACKPOT:
+		return lang.MustLocalize("monopoly.house_rule.free_parking_jackpot", langTag)
+	case DOUBLE_SALARY_ON_GO:
+		return lang.MustLocalize("monopoly.house_rule.double_salary_on_go", langTag)
+	case NO_RENT_IN_JAIL:
+		return lang.MustLocalize("monopoly.house_rule.no_rent_in_jail", langTag)
+	case NO_BUYING_FIRST_LAP:
+		return lang.MustLocalize("monopoly.house_rule.no_buying_first_lap", langTag)
+	}
+
+	var rules []string
+	for _, rule := range AllHouseRules() {
+		if hr.Has(rule) {
+			rules = append(rules, rule.Localize(langTag))
+		}
+	}
+	return strings.Join(rules, ", ")
+}
+
+// GoString implements [fmt.GoStringer] interface.
+func (hr HouseRule) GoString() string {
+	switch hr {
+	case FREE_PARKING_JACKPOT:
+		return "FREE_PARKING_JACKPOT"
+	case DOUBLE_SALARY_ON_GO:
+		return "DOUBLE_SALARY_ON_GO"
+	case NO_RENT_IN_JAIL:
+		return "NO_RENT_IN_JAIL"
+	case NO_BUYING_FIRST_LAP:
+		return "NO_BUYING_FIRST_LAP"
+	}
+
+	var rules []string
+	for _, rule := range AllHouseRules() {
+		if hr.Has(rule) {
+			rules = append(rules, rule.GoString())
+		}
+	}
+	if len(rules) == 0 {
+		return "0"
+	}
+	return strings.Join(rules, "|")
+}
@@ -0,0 +1,80 @@
+package monopoly
+
+import "testing"
+
+func TestHouseRule_Has(t *testing.T) {
+	hr := FREE_PARKING_JACKPOT | NO_RENT_IN_JAIL
+	if !hr.Has(FREE_PARKING_JACKPOT) || !hr.Has(NO_RENT_IN_JAIL) || hr.Has(DOUBLE_SALARY_ON_GO) {
+		t.Errorf("%#v.Has() reported wrong house rules", hr)
+	}
+	if got, want := hr.GoString(), "FREE_PARKING_JACKPOT|NO_RENT_IN_JAIL"; got != want {
+		t.Errorf("HouseRule.GoString() got = %q, want %q", got, want)
+	}
+}
+
+func TestFreeParkingJackpot(t *testing.T) {
+	rules := DefaultRules()
+	rules.HouseRules = FREE_PARKING_JACKPOT
+	g := NewGame(rules, CAR, DOG)
+	p, other := g.players[0], g.players[1]
+
+	p.position = INCOME_TAX
+	p.land()
+	p.position = CHANCE_1
+	g.chance = Deck{CHANCE_SPEEDING_FINE}
+	p.land()
+	p.resolveCard()
+	if want := rules.Board.Tax(INCOME_TAX) + 15; g.Jackpot() != want {
+		t.Fatalf("Game.Jackpot() got = %d, want %d", g.Jackpot(), want)
+	}
+
+	other.position = FREE_PARKING
+	other.land()
+	if want := rules.StartMoney + rules.Board.Tax(INCOME_TAX) + 15; other.money != want || g.Jackpot() != 0 {
+		t.Errorf("after landing on Free Parking: money = %d, jackpot = %d, want %d and 0", other.money, g.Jackpot(), want)
+	}
+}
+
+func TestNoRentInJail(t *testing.T) {
+	rules := DefaultRules()
+	rules.HouseRules = NO_RENT_IN_JAIL
+	g := NewGame(rules, CAR, DOG)
+	p, owner := g.players[0], g.players[1]
+	owner.inventory[BOARDWALK] = STATE_NORMAL
+
+	if p.RentAt(Field(BOARDWALK), 0) == 0 {
+		t.Error("Player.RentAt() with owner not in jail got = 0")
+	}
+	owner.goToJail()
+	if rent := p.RentAt(Field(BOARDWALK), 0); rent != 0 {
+		t.Errorf("Player.RentAt() with owner in jail got = %d, want 0", rent)
+	}
+
+	owner.inventory[Property(ELECTRIC_COMPANY)] = STATE_NORMAL
+	p.position = CHANCE_1
+	p.applyCard(CHANCE_ADVANCE_TO_NEAREST_UTILITY)
+	if p.position != Field(ELECTRIC_COMPANY) || p.money != rules.StartMoney {
+		t.Errorf("after advancing to the utility of an owner in jail: position = %d, money = %d, want %d", p.position, p.money, rules.StartMoney)
+	}
+}
+
+func TestNoBuyingFirstLap(t *testing.T) {
+	rules := DefaultRules()
+	rules.HouseRules = NO_BUYING_FIRST_LAP | DOUBLE_SALARY_ON_GO
+	g := NewGame(rules, CAR, DOG)
+	p, _ := g.GetCurrentPlayer()
+	p.position = Field(BOARDWALK)
+	g.state = GAME_BUY_DECISION
+
+	if ok, _ := p.CanBuyProperty(); ok {
+		t.Error("Player.CanBuyProperty() on the first lap got = true, want false")
+	}
+	p.advanceTo(GO)
+	if want := rules.StartMoney + 2*rules.MoneyOnGo; p.money != want {
+		t.Errorf("Player landing on GO has %d money, want %d", p.money, want)
+	}
+	p.position = Field(BOARDWALK)
+	if ok, _ := p.CanBuyProperty(); !ok {
+		t.Error("Player.CanBuyProperty() after crossing GO got = false, want true")
+	}
+}
@@ -0,0 +1,185 @@
+package monopoly
+
+import "fmt"
+
+// LegalActions returns every action the player with the token t can take right now with
+// [Game.Apply]. Actions involving money are listed once with a representative amount:
+//   - bids with the minimum bid, any higher bid the player can afford is legal as well
+//   - transfers with an amount of 0, the receiving player may pay any amount they can afford
+//
+// Trade proposals can be combined too freely to be listed one by one. When the player may propose
+// trades, [ACTION_PROPOSE_TRADE] is listed once without Target and Trade. Use [Game.CanApply] to
+// check a concrete proposal.
+func (g *Game) LegalActions(t Token) []Action {
+	g.lock()
+	defer g.unlock()
+	p := g.player(t)
+	if p == nil || p.bankrupt {
+		return nil
+	}
+	return p.legalActions()
+}
+
+// legalActions is like LegalActions, but expects the game to be locked.
+func (p *Player) legalActions() []Action {
+	var legal []Action
+	for _, a := range p.candidateActions() {
+		err := p.game.validate(a)
+		if a.Kind == ACTION_PROPOSE_TRADE {
+			err = p.canProposeAnyTrade()
+		}
+		if err == nil {
+			legal = append(legal, a)
+		}
+	}
+	return legal
+}
+
+// CanApply returns an error why a can't be applied with [Game.Apply] right now, or nil if it can.
+func (g *Game) CanApply(a Action) error {
+	g.lock()
+	defer g.unlock()
+	return g.validate(a)
+}
+
+// candidateActions returns all actions p could possibly take, without checking whether they are
+// legal right now.
+func (p *Player) candidateActions() []Action {
+	var props []Property
+	for f := GO; int(f) < numberOfFields; f++ {
+		if prop, ok := p.game.board.Property(f); ok {
+			if _, hasProp := p.inventory[prop]; hasProp {
+				props = append(props, prop)
+			}
+		}
+	}
+	var others []*Player
+	for _, other := range p.game.players {
+		if other != p && !other.bankrupt {
+			others = append(others, other)
+		}
+	}
+
+	actions := []Action{
+		{Kind: ACTION_ROLL_DICE},
+		{Kind: ACTION_PAY_JAIL_FINE},
+		{Kind: ACTION_USE_JAIL_CARD},
+		{Kind: ACTION_MOVE},
+		{Kind: ACTION_BUY_PROPERTY},
+		{Kind: ACTION_DECLINE_PROPERTY},
+		{Kind: ACTION_CONTINUE},
+		{Kind: ACTION_PASS_AUCTION},
+		{Kind: ACTION_PAY_DEBTS},
+		{Kind: ACTION_DECLARE_BANKRUPTCY},
+		{Kind: ACTION_PROPOSE_TRADE},
+		{Kind: ACTION_ACCEPT_TRADE},
+		{Kind: ACTION_REJECT_TRADE},
+		{Kind: ACTION_AUCTION_BUILDING, Building: HOUSE},
+		{Kind: ACTION_AUCTION_BUILDING, Building: HOTEL},
+	}
+	if a := p.game.auction; a != nil {
+		actions = append(actions, Action{Kind: ACTION_BID, Amount: a.MinimumBid()})
+	}
+	for _, prop := range props {
+		actions = append(actions,
+			Action{Kind: ACTION_MORTGAGE_PROPERTY, Property: prop},
+			Action{Kind: ACTION_CANCEL_MORTGAGE_PROPERTY, Property: prop},
+			Action{Kind: ACTION_BUY_HOUSE, Property: prop},
+			Action{Kind: ACTION_SELL_HOUSE, Property: prop},
+		)
+	}
+	for _, other := range others {
+		for _, prop := range props {
+			actions = append(actions, Action{Kind: ACTION_TRANSFER_PROPERTY, Property: prop, Target: other.token})
+		}
+		for _, c := range p.jailCards() {
+			actions = append(actions, Action{Kind: ACTION_TRANSFER_JAIL_CARD, Card: c, Target: other.token})
+		}
+	}
+	actions = append(actions, Action{Kind: ACTION_END_TURN})
+
+	for i := range actions {
+		actions[i].Player = p.token
+	}
+	return actions
+}
+
+// validate returns an error why a can't be applied with [Game.Apply] right now. It expects g to be
+// locked.
+func (g *Game) validate(a Action) error {
+	states := statesFor(a.Kind)
+	if len(states) == 0 {
+		return fmt.Errorf("unknown action kind %d", a.Kind)
+	}
+	if err := g.checkState(states...); err != nil {
+		return err
+	}
+	p := g.player(a.Player)
+	if p == nil {
+		return fmt.Errorf("%w: %#v", ErrUnknownPlayer, a.Player)
+	}
+	if p.bankrupt {
+		return ErrBankrupt
+	}
+
+	switch a.Kind {
+	case ACTION_ROLL_DICE:
+		return p.checkTurn(GAME_TURN_START, GAME_IN_JAIL)
+	case ACTION_MOVE:
+		return p.checkTurn(GAME_ROLLED_DICE)
+	case ACTION_CONTINUE:
+		return p.checkTurn(GAME_MOVED_TO_NEW_FIELD, GAME_CARD)
+	case ACTION_BUY_PROPERTY:
+		_, err := p.canBuyProperty()
+		return err
+	case ACTION_DECLINE_PROPERTY:
+		return p.canDeclineProperty()
+	case ACTION_BID:
+		return p.canBid(a.Amount)
+	case ACTION_PASS_AUCTION:
+		return p.canPassAuction()
+	case ACTION_AUCTION_BUILDING:
+		return p.canAuctionBuilding(a.Building)
+	case ACTION_MORTGAGE_PROPERTY:
+		return p.canMortgageProperty(a.Property)
+	case ACTION_CANCEL_MORTGAGE_PROPERTY:
+		return p.canCancelMortgageProperty(a.Property)
+	case ACTION_BUY_HOUSE:
+		return p.canBuyHouse(a.Property)
+	case ACTION_SELL_HOUSE:
+		return p.canSellHouse(a.Property)
+	case ACTION_TRANSFER_PROPERTY, ACTION_TRANSFER_JAIL_CARD:
+		target := g.player(a.Target)
+		if target == nil {
+			return fmt.Errorf("%w: %#v", ErrUnknownPlayer, a.Target)
+		}
+		if a.Kind == ACTION_TRANSFER_PROPERTY {
+			return p.canTransferProperty(target, a.Property, a.Amount)
+		}
+		return p.canTransferJailCard(target, a.Card, a.Amount)
+	case ACTION_PAY_JAIL_FINE:
+		return p.canPayJailFine()
+	case ACTION_USE_JAIL_CARD:
+		return p.canUseJailCard()
+	case ACTION_PAY_DEBTS:
+		return p.canPayDebts()
+	case ACTION_DECLARE_BANKRUPTCY:
+		return p.canDeclareBankruptcy()
+	case ACTION_END_TURN:
+		return p.checkTurn(GAME_TURN)
+	case ACTION_PROPOSE_TRADE:
+		target := g.player(a.Target)
+		if target == nil {
+			return fmt.Errorf("%w: %#v", ErrUnknownPlayer, a.Target)
+		}
+		if a.Trade == nil {
+			return ErrEmptyTrade
+		}
+		return p.canProposeTrade(target, *a.Trade)
+	case ACTION_ACCEPT_TRADE:
+		return p.canAcceptTrade()
+	case ACTION_REJECT_TRADE:
+		return p.canRejectTrade()
+	}
+	return nil
+}
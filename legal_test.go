@@ -0,0 +1,88 @@
+package monopoly
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestGame_LegalActions(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG)
+	p, _ := g.GetCurrentPlayer()
+	other := g.players[1-g.currentTurn]
+
+	if got, want := g.LegalActions(p.token), []Action{{Kind: ACTION_ROLL_DICE, Player: p.token}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Game.LegalActions() at the start got %v, want %v", got, want)
+	}
+	if got := g.LegalActions(other.token); len(got) != 0 {
+		t.Errorf("Game.LegalActions() out of turn got %v, want none", got)
+	}
+
+	p.inventory[MEDITERRANEAN_AVENUE] = STATE_NORMAL
+	p.inventory[BALTIC_AVENUE] = STATE_MORTGAGE
+	p.position = Field(BOARDWALK)
+	g.state = GAME_BUY_DECISION
+	want := map[Action]bool{
+		{Kind: ACTION_BUY_PROPERTY, Player: p.token}:                                                           true,
+		{Kind: ACTION_DECLINE_PROPERTY, Player: p.token}:                                                       true,
+		{Kind: ACTION_MORTGAGE_PROPERTY, Player: p.token, Property: MEDITERRANEAN_AVENUE}:                      true,
+		{Kind: ACTION_CANCEL_MORTGAGE_PROPERTY, Player: p.token, Property: BALTIC_AVENUE}:                      true,
+		{Kind: ACTION_TRANSFER_PROPERTY, Player: p.token, Property: MEDITERRANEAN_AVENUE, Target: other.token}: true,
+		{Kind: ACTION_TRANSFER_PROPERTY, Player: p.token, Property: BALTIC_AVENUE, Target: other.token}:        true,
+	}
+	got := g.LegalActions(p.token)
+	if len(got) != len(want) {
+		t.Errorf("Game.LegalActions() got %d actions %v, want %d", len(got), got, len(want))
+	}
+	for _, a := range got {
+		if !want[a] {
+			t.Errorf("Game.LegalActions() got unexpected action %#v", a)
+		}
+	}
+
+	g.state = GAME_TURN
+	propose := Action{Kind: ACTION_PROPOSE_TRADE, Player: p.token}
+	if got := g.LegalActions(p.token); !slices.Contains(got, propose) {
+		t.Errorf("Game.LegalActions() during a turn got %v, want it to contain %v", got, propose)
+	}
+	other.bankrupt = true
+	if got := g.LegalActions(p.token); slices.Contains(got, propose) {
+		t.Errorf("Game.LegalActions() without a trade partner got %v, want no %v", got, propose)
+	}
+}
+
+// TestGame_LegalActions_apply plays random legal actions and checks that every one of them can be
+// applied.
+func TestGame_LegalActions_apply(t *testing.T) {
+	rules := DefaultRules()
+	rules.Random = NewSeededSource(5)
+	g := NewGame(rules, CAR, DOG, HAT)
+	r := rand.New(rand.NewSource(5))
+
+	for i := 0; i < 2000; i++ {
+		var legal []Action
+		for _, p := range g.Players() {
+			legal = append(legal, g.LegalActions(p.token)...)
+		}
+		if len(legal) == 0 {
+			if _, state := g.GetCurrentPlayer(); state != GAME_OVER {
+				t.Fatalf("no legal actions in state %#v", state)
+			}
+			return
+		}
+		a := legal[r.Intn(len(legal))]
+		if a.Kind == ACTION_TRANSFER_PROPERTY || a.Kind == ACTION_TRANSFER_JAIL_CARD {
+			continue // gifts would decide the game too quickly
+		}
+		if a.Kind == ACTION_PROPOSE_TRADE {
+			continue // needs a concrete trade
+		}
+		if err := g.CanApply(a); err != nil {
+			t.Fatalf("Game.CanApply(%#v) got error: %v", a, err)
+		}
+		if _, err := g.Apply(a); err != nil {
+			t.Fatalf("Game.Apply(%#v) of a legal action got error: %v", a, err)
+		}
+	}
+}
@@ -3,8 +3,8 @@ package monopoly
 import (
 	"fmt"
 	"strings"
-	"sync"
 
+	"github.com/Kesuaheli/monopoly/lang"
 	"golang.org/x/text/language"
 )
 
@@ -14,19 +14,10 @@ func (inv Inventory) String() string {
 	return inv.Localize(language.English)
 }
 
+// Localize returns the localized names of all properties in inv with the names of the classic
+// board. Use [Board.LocalizeInventory] for other boards.
 func (inv Inventory) Localize(langTag language.Tag) string {
-	if len(inv) == 0 {
-		return "no properties"
-	}
-	var props []string
-	for prop, state := range inv {
-		if state == STATE_NORMAL {
-			props = append(props, prop.Localize(langTag))
-		} else {
-			props = append(props, prop.Localize(langTag)+" "+state.Localize(langTag))
-		}
-	}
-	return strings.Join(props, ", ")
+	return DefaultBoard().LocalizeInventory(inv, langTag)
 }
 
 func (inv Inventory) GoString() string {
@@ -50,8 +41,11 @@ type Player struct {
 	position     Field
 	money        int
 	inventory    Inventory
-	invLock      sync.Mutex
 	roundsInJail int
+	debts        []debt
+	bankrupt     bool
+	reserved     [2]int // buildings won in auctions, indexed by Building
+	passedGo     bool
 }
 
 func InitPlayer(g *Game, t Token) *Player {
@@ -59,168 +53,611 @@ func InitPlayer(g *Game, t Token) *Player {
 		game:      g,
 		token:     t,
 		position:  GO,
-		money:     startMoney,
+		money:     g.rules.StartMoney,
 		inventory: map[Property]PropertyState{},
 	}
 }
 
 func (p *Player) String() string {
-	return fmt.Sprintf("%s (%s) is on %s and owns %s.", p.Token(), p.game.FormatCurrency(p.money), p.position.Localize(p.game.Language), p.inventory.Localize(p.game.Language))
+	p.game.lock()
+	defer p.game.unlock()
+	return p.string()
+}
+
+// string is like String, but expects the game to be locked.
+func (p *Player) string() string {
+	owns := p.game.board.LocalizeInventory(p.inventory, p.game.Language)
+	for range p.jailCards() {
+		owns += ", " + lang.MustLocalize("monopoly.card.get_out_of_jail_free", p.game.Language)
+	}
+	return fmt.Sprintf("%s (%s) is on %s and owns %s.", p.token.Localize(p.game.Language), formatCurrency(p.money, p.game.Language), p.game.board.Localize(p.position, p.game.Language), owns)
 }
 
 func (p *Player) GoString() string {
-	return fmt.Sprintf("{token: %#v, money: %d, position: %#v, inventory: %#v}", p.token, p.money, p.position, p.inventory)
+	p.game.lock()
+	defer p.game.unlock()
+	return p.goString()
+}
+
+// goString is like GoString, but expects the game to be locked.
+func (p *Player) goString() string {
+	return fmt.Sprintf("{token: %#v, money: %d, position: %#v, inventory: %#v, jailCards: %#v}", p.token, p.money, p.position, p.inventory, p.jailCards())
 }
 
 // Money returns the amount of money the player currently has, formatted with the currency symbol of
 // the selected language.
 func (p *Player) Money() string {
-	return p.game.FormatCurrency(p.money)
+	p.game.lock()
+	defer p.game.unlock()
+	return formatCurrency(p.money, p.game.Language)
 }
 
 // Token returns the localized name of the token the player has.
 func (p *Player) Token() string {
+	p.game.lock()
+	defer p.game.unlock()
 	return p.token.Localize(p.game.Language)
 }
 
-// Railroads returns the amount of railroads the player owns.
+// Position returns the field p is currently standing on.
+func (p *Player) Position() Field {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.position
+}
+
+// Railroads returns the amount of unmortgaged railroads the player owns.
 func (p *Player) Railroads() int {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.railroads()
+}
+
+// railroads is like Railroads, but expects the game to be locked.
+func (p *Player) railroads() int {
 	var rrCount int
-	for prop := range p.inventory {
-		if _, isRR := prop.Railroad(); isRR {
+	for prop, state := range p.inventory {
+		if p.game.board.Kind(Field(prop)) == KIND_RAILROAD && state != STATE_MORTGAGE {
 			rrCount++
 		}
 	}
 	return rrCount
 }
 
-// Utilities returns the amount of utilities the player owns.
+// Utilities returns the amount of unmortgaged utilities the player owns.
 func (p *Player) Utilities() int {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.utilities()
+}
+
+// utilities is like Utilities, but expects the game to be locked.
+func (p *Player) utilities() int {
 	var utilCount int
-	for prop := range p.inventory {
-		if _, isUtil := prop.Utility(); isUtil {
+	for prop, state := range p.inventory {
+		if p.game.board.Kind(Field(prop)) == KIND_UTILITY && state != STATE_MORTGAGE {
 			utilCount++
 		}
 	}
 	return utilCount
 }
 
+// JailCards returns all Get Out of Jail Free cards p currently holds.
+func (p *Player) JailCards() []Card {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.jailCards()
+}
+
+// jailCards is like JailCards, but expects the game to be locked.
+func (p *Player) jailCards() []Card {
+	var cards []Card
+	for _, c := range []Card{CHANCE_GET_OUT_OF_JAIL_FREE, COMMUNITY_CHEST_GET_OUT_OF_JAIL_FREE} {
+		if holder, ok := p.game.jailCards[c]; ok && holder == p {
+			cards = append(cards, c)
+		}
+	}
+	return cards
+}
+
 func (p *Player) CanBuyProperty() (bool, Property) {
-	prop, ok := p.position.Property()
-	if !ok {
+	p.game.lock()
+	defer p.game.unlock()
+	prop, err := p.canBuyProperty()
+	if err != nil {
 		return false, -1
 	}
-	if p.money < prop.GetBaseCost() {
-		return false, -1
+	return true, prop
+}
+
+// canBuyProperty returns the property p is standing on, or an error why p can't buy it.
+func (p *Player) canBuyProperty() (Property, error) {
+	if err := p.checkTurn(GAME_BUY_DECISION); err != nil {
+		return -1, err
+	}
+	prop, ok := p.game.board.Property(p.position)
+	if !ok {
+		return -1, ErrNotAProperty
+	}
+	if !p.game.isPropertyAvailable(prop) {
+		return -1, ErrPropertyOwned
 	}
+	if !p.mayBuy() {
+		return -1, ErrFirstLap
+	}
+	if err := p.checkFunds(p.game.board.Price(prop)); err != nil {
+		return -1, err
+	}
+	return prop, nil
+}
 
-	return p.game.IsPropertyAvailable(prop), prop
+// mayBuy reports whether p is allowed to buy properties at all. With the [NO_BUYING_FIRST_LAP]
+// house rule p has to cross GO once first.
+func (p *Player) mayBuy() bool {
+	return p.passedGo || !p.game.rules.HouseRules.Has(NO_BUYING_FIRST_LAP)
 }
 
-func (p *Player) BuyProperty() bool {
-	canBuy, prop := p.CanBuyProperty()
-	if !canBuy {
-		return false
-	}
-	p.money -= prop.GetBaseCost()
+// BuyProperty makes p buy the property they are standing on from the bank and returns it.
+func (p *Player) BuyProperty() (Property, error) {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.buyProperty()
+}
 
-	p.invLock.Lock()
-	defer p.invLock.Unlock()
+// buyProperty is like BuyProperty, but expects the game to be locked.
+func (p *Player) buyProperty() (Property, error) {
+	prop, err := p.canBuyProperty()
+	if err != nil {
+		return -1, err
+	}
+	p.game.record(Action{Kind: ACTION_BUY_PROPERTY, Player: p.token})
+	p.money -= p.game.board.Price(prop)
 	p.inventory[prop] = STATE_NORMAL
-	return true
+	p.game.state = GAME_TURN
+	p.game.emit(PropertyBoughtEvent{Player: p, Property: prop, Price: p.game.board.Price(prop)})
+	return prop, nil
+}
+
+// TransferProperty gives prop from p to toPlayer, who pays money for it. A street can't be given
+// away while there are buildings on any street of its color group.
+func (p *Player) TransferProperty(toPlayer *Player, prop Property, money int) error {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.transferProperty(toPlayer, prop, money)
 }
 
-func (p *Player) TransferProperty(toPlayer *Player, prop Property, money int) bool {
-	p.invLock.Lock()
-	defer p.invLock.Unlock()
-	if _, hasProp := p.inventory[prop]; !hasProp || toPlayer.money < money {
-		return false
+// transferProperty is like TransferProperty, but expects the game to be locked.
+func (p *Player) transferProperty(toPlayer *Player, prop Property, money int) error {
+	if err := p.canTransferProperty(toPlayer, prop, money); err != nil {
+		return err
 	}
+	p.game.record(Action{Kind: ACTION_TRANSFER_PROPERTY, Player: p.token, Property: prop, Target: toPlayer.token, Amount: money})
 
 	p.money += money
 	toPlayer.money -= money
-
-	toPlayer.invLock.Lock()
-	defer toPlayer.invLock.Unlock()
 	toPlayer.inventory[prop] = p.inventory[prop]
 	delete(p.inventory, prop)
+	return nil
+}
+
+// TransferJailCard gives the Get Out of Jail Free card c from p to toPlayer, who pays money for it.
+func (p *Player) TransferJailCard(toPlayer *Player, c Card, money int) error {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.transferJailCard(toPlayer, c, money)
+}
+
+// canTransferProperty returns an error why p can't give prop to toPlayer for money.
+func (p *Player) canTransferProperty(toPlayer *Player, prop Property, money int) error {
+	if err := p.game.checkAction(ACTION_TRANSFER_PROPERTY); err != nil {
+		return err
+	}
+	if _, hasProp := p.inventory[prop]; !hasProp {
+		return ErrNotOwner
+	}
+	if err := p.checkGroupBuildings(prop); err != nil {
+		return err
+	}
+	return p.checkRecipient(toPlayer, money)
+}
+
+// transferJailCard is like TransferJailCard, but expects the game to be locked.
+func (p *Player) transferJailCard(toPlayer *Player, c Card, money int) error {
+	if err := p.canTransferJailCard(toPlayer, c, money); err != nil {
+		return err
+	}
+	p.game.record(Action{Kind: ACTION_TRANSFER_JAIL_CARD, Player: p.token, Card: c, Target: toPlayer.token, Amount: money})
+
+	p.money += money
+	toPlayer.money -= money
+	p.game.jailCards[c] = toPlayer
+	return nil
+}
+
+// canTransferJailCard returns an error why p can't give the card c to toPlayer for money.
+func (p *Player) canTransferJailCard(toPlayer *Player, c Card, money int) error {
+	if err := p.game.checkAction(ACTION_TRANSFER_JAIL_CARD); err != nil {
+		return err
+	}
+	if holder, ok := p.game.jailCards[c]; !ok || holder != p {
+		return ErrNotCardHolder
+	}
+	return p.checkRecipient(toPlayer, money)
+}
+
+// HasMonopoly reports whether p owns every street of the color group cg.
+func (p *Player) HasMonopoly(cg ColorGroup) bool {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.hasMonopoly(cg)
+}
+
+// hasMonopoly is like HasMonopoly, but expects the game to be locked.
+func (p *Player) hasMonopoly(cg ColorGroup) bool {
+	for _, prop := range p.game.board.Properties(cg) {
+		if _, hasProp := p.inventory[prop]; !hasProp {
+			return false
+		}
+	}
 	return true
 }
 
-func (p *Player) MortgageProperty(prop Property) bool {
-	p.invLock.Lock()
-	defer p.invLock.Unlock()
-	if state, hasProp := p.inventory[prop]; !hasProp || state != STATE_NORMAL {
-		return false
+// groupStates returns the states of all streets in the color group of prop, that p owns.
+func (p *Player) groupStates(prop Property) []PropertyState {
+	cg, ok := p.game.board.ColorGroup(prop)
+	if !ok {
+		return nil
+	}
+	var states []PropertyState
+	for _, groupProp := range p.game.board.Properties(cg) {
+		if state, hasProp := p.inventory[groupProp]; hasProp {
+			states = append(states, state)
+		}
+	}
+	return states
+}
+
+// MortgageProperty mortgages prop to the bank. A street can't be mortgaged while there are buildings
+// on any street of its color group.
+func (p *Player) MortgageProperty(prop Property) error {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.mortgageProperty(prop)
+}
+
+// mortgageProperty is like MortgageProperty, but expects the game to be locked.
+func (p *Player) mortgageProperty(prop Property) error {
+	if err := p.canMortgageProperty(prop); err != nil {
+		return err
 	}
-	p.money += prop.GetMortgageValue()
+	p.game.record(Action{Kind: ACTION_MORTGAGE_PROPERTY, Player: p.token, Property: prop})
+	p.money += p.game.board.MortgageValue(prop)
 	p.inventory[prop] = STATE_MORTGAGE
-	return true
+	return nil
+}
+
+// canMortgageProperty returns an error why p can't mortgage prop.
+func (p *Player) canMortgageProperty(prop Property) error {
+	if err := p.game.checkAction(ACTION_MORTGAGE_PROPERTY); err != nil {
+		return err
+	}
+	state, hasProp := p.inventory[prop]
+	if !hasProp {
+		return ErrNotOwner
+	}
+	if state == STATE_MORTGAGE {
+		return ErrPropertyMortgaged
+	}
+	return p.checkGroupBuildings(prop)
 }
 
-func (p *Player) CancelMortgageProperty(prop Property) bool {
-	cost := int(float32(prop.GetMortgageValue()) * 1.1)
-	p.invLock.Lock()
-	defer p.invLock.Unlock()
-	if state, hasProp := p.inventory[prop]; !hasProp || state != STATE_MORTGAGE || p.money < cost {
-		return false
+// checkGroupBuildings returns [ErrBuildingsInGroup] if any street p owns in the color group of prop
+// has buildings.
+func (p *Player) checkGroupBuildings(prop Property) error {
+	for _, state := range p.groupStates(prop) {
+		if state > STATE_NORMAL {
+			return ErrBuildingsInGroup
+		}
 	}
+	return nil
+}
 
-	p.money -= cost
+// CancelMortgageProperty lifts the mortgage from prop. p has to pay back the mortgage value plus
+// [Rules.MortgageInterest].
+func (p *Player) CancelMortgageProperty(prop Property) error {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.cancelMortgageProperty(prop)
+}
+
+// cancelMortgageProperty is like CancelMortgageProperty, but expects the game to be locked.
+func (p *Player) cancelMortgageProperty(prop Property) error {
+	if err := p.canCancelMortgageProperty(prop); err != nil {
+		return err
+	}
+	p.game.record(Action{Kind: ACTION_CANCEL_MORTGAGE_PROPERTY, Player: p.token, Property: prop})
+
+	p.money -= p.game.cancelMortgageCost(prop)
 	p.inventory[prop] = STATE_NORMAL
-	return true
+	return nil
 }
 
+// canCancelMortgageProperty returns an error why p can't lift the mortgage from prop.
+func (p *Player) canCancelMortgageProperty(prop Property) error {
+	if err := p.game.checkAction(ACTION_CANCEL_MORTGAGE_PROPERTY); err != nil {
+		return err
+	}
+	state, hasProp := p.inventory[prop]
+	if !hasProp {
+		return ErrNotOwner
+	}
+	if state != STATE_MORTGAGE {
+		return ErrPropertyNotMortgaged
+	}
+	return p.checkFunds(p.game.cancelMortgageCost(prop))
+}
+
+// cancelMortgageCost returns the amount to pay back for lifting the mortgage from prop.
+func (g *Game) cancelMortgageCost(prop Property) int {
+	return g.board.MortgageValue(prop) * (100 + g.rules.MortgageInterest) / 100
+}
+
+// CanBuildHouse reports whether p is allowed to build another house (or hotel) on prop. This
+// requires p to own the whole color group without any mortgages. Houses have to be built evenly, so
+// prop must not have more houses than any other street of its group.
 func (p *Player) CanBuildHouse(prop Property) bool {
-	p.invLock.Lock()
-	defer p.invLock.Unlock()
+	p.game.lock()
+	defer p.game.unlock()
+	return p.canBuildHouse(prop) == nil
+}
+
+// canBuildHouse is like CanBuildHouse, but returns an error why p can't build on prop.
+func (p *Player) canBuildHouse(prop Property) error {
 	state, hasProp := p.inventory[prop]
-	return hasProp && (state == STATE_NORMAL || state == STATE_HOUSE_1 || state == STATE_HOUSE_2 || state == STATE_HOUSE_3 || state == STATE_HOUSE_4)
+	if !hasProp {
+		return ErrNotOwner
+	}
+	cg, isStreet := p.game.board.ColorGroup(prop)
+	if !isStreet {
+		return ErrNotAStreet
+	}
+	switch state {
+	case STATE_MORTGAGE:
+		return ErrPropertyMortgaged
+	case STATE_HOTEL:
+		return ErrMaxBuildings
+	}
+	if !p.hasMonopoly(cg) {
+		return ErrNoMonopoly
+	}
+	for _, groupState := range p.groupStates(prop) {
+		if groupState == STATE_MORTGAGE {
+			return ErrPropertyMortgaged
+		}
+		if groupState < state {
+			return ErrUnevenBuilding
+		}
+	}
+	return nil
 }
 
+// CanBuyHouse reports whether p can build and afford another house (or hotel) on prop right now.
+// Besides [Player.CanBuildHouse] the bank must have a building left, which is not subject to a
+// housing shortage. A building p won in an auction is always available and already paid.
 func (p *Player) CanBuyHouse(prop Property) bool {
-	return p.CanBuildHouse(prop) && p.money >= prop.GetHouseCost()
+	p.game.lock()
+	defer p.game.unlock()
+	return p.canBuyHouse(prop) == nil
+}
+
+// canBuyHouse is like CanBuyHouse, but returns an error why p can't buy a building for prop.
+func (p *Player) canBuyHouse(prop Property) error {
+	if err := p.game.checkAction(ACTION_BUY_HOUSE); err != nil {
+		return err
+	}
+	if err := p.canBuildHouse(prop); err != nil {
+		return err
+	}
+	b := nextBuilding(p.inventory[prop])
+	if p.reserved[b] > 0 {
+		return nil
+	}
+	if *p.game.supply(b) == 0 {
+		return ErrNoBuildingsLeft
+	}
+	if p.game.housingShortage(b) {
+		return ErrHousingShortage
+	}
+	return p.checkFunds(p.game.board.HouseCost(prop))
+}
+
+// BuyHouse makes p build another house (or hotel) on prop and returns the new state of prop.
+func (p *Player) BuyHouse(prop Property) (PropertyState, error) {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.buyHouse(prop)
 }
 
-func (p *Player) BuyHouse(prop Property) (PropertyState, bool) {
-	if !p.CanBuyHouse(prop) {
-		return -1, false
+// buyHouse is like BuyHouse, but expects the game to be locked.
+func (p *Player) buyHouse(prop Property) (PropertyState, error) {
+	if err := p.canBuyHouse(prop); err != nil {
+		return -1, err
 	}
+	p.game.record(Action{Kind: ACTION_BUY_HOUSE, Player: p.token, Property: prop})
 
-	p.money -= prop.GetHouseCost()
-	p.invLock.Lock()
-	defer p.invLock.Unlock()
+	b := nextBuilding(p.inventory[prop])
+	if p.reserved[b] > 0 {
+		p.reserved[b]--
+	} else {
+		p.money -= p.game.board.HouseCost(prop)
+		*p.game.supply(b)--
+	}
+	if b == HOTEL {
+		p.game.houses += 4
+	}
 	p.inventory[prop] += 1
 
-	return p.inventory[prop], true
+	return p.inventory[prop], nil
 }
 
+// CanSellHouse reports whether p is allowed to sell a house (or hotel) from prop. Houses have to be
+// sold evenly, so prop must not have less houses than any other street of its group. Selling a
+// hotel requires the bank to have four houses left to replace it.
 func (p *Player) CanSellHouse(prop Property) bool {
-	p.invLock.Lock()
-	defer p.invLock.Unlock()
+	p.game.lock()
+	defer p.game.unlock()
+	return p.canSellHouse(prop) == nil
+}
+
+// canSellHouse is like CanSellHouse, but returns an error why p can't sell a building from prop.
+func (p *Player) canSellHouse(prop Property) error {
+	if err := p.game.checkAction(ACTION_SELL_HOUSE); err != nil {
+		return err
+	}
 	state, hasProp := p.inventory[prop]
-	return hasProp && (state == STATE_HOUSE_1 || state == STATE_HOUSE_2 || state == STATE_HOUSE_3 || state == STATE_HOUSE_4 || state == STATE_HOTEL)
+	if !hasProp {
+		return ErrNotOwner
+	}
+	if state <= STATE_NORMAL {
+		return ErrNoBuildings
+	}
+	if state == STATE_HOTEL && p.game.houses < 4 {
+		return ErrNoBuildingsLeft
+	}
+	for _, groupState := range p.groupStates(prop) {
+		if groupState > state {
+			return ErrUnevenBuilding
+		}
+	}
+	return nil
+}
+
+// SellHouse makes p sell a house (or hotel) from prop to the bank for half its price and returns
+// the new state of prop.
+func (p *Player) SellHouse(prop Property) (PropertyState, error) {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.sellHouse(prop)
 }
 
-func (p *Player) SellHouse(prop Property) (PropertyState, bool) {
-	if !p.CanSellHouse(prop) {
-		return -1, false
+// sellHouse is like SellHouse, but expects the game to be locked.
+func (p *Player) sellHouse(prop Property) (PropertyState, error) {
+	if err := p.canSellHouse(prop); err != nil {
+		return -1, err
 	}
+	p.game.record(Action{Kind: ACTION_SELL_HOUSE, Player: p.token, Property: prop})
 
-	p.money += prop.GetHouseCost() / 2
-	p.invLock.Lock()
-	defer p.invLock.Unlock()
+	p.money += p.game.board.HouseCost(prop) / 2
+	if p.inventory[prop] == STATE_HOTEL {
+		p.game.hotels++
+		p.game.houses -= 4
+	} else {
+		p.game.houses++
+	}
 	p.inventory[prop] -= 1
 
-	return p.inventory[prop], true
+	return p.inventory[prop], nil
+}
+
+// InJail reports whether p is currently sitting in jail.
+func (p *Player) InJail() bool {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.position == IN_JAIL
 }
 
-func (p *Player) RollDice() (int, int, Field) {
-	if p.game.state != GAME_TURN_START {
-		return -1, -1, -1
+// RoundsInJail returns the number of times p already failed to roll doubles while sitting in jail.
+func (p *Player) RoundsInJail() int {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.roundsInJail
+}
+
+// PayJailFine makes p pay the fine to get out of jail before rolling the dice. Afterwards p continues
+// with a normal turn.
+func (p *Player) PayJailFine() error {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.payJailFine()
+}
+
+// payJailFine is like PayJailFine, but expects the game to be locked.
+func (p *Player) payJailFine() error {
+	if err := p.canPayJailFine(); err != nil {
+		return err
 	}
-	if curr, _ := p.game.GetCurrentPlayer(); curr != p {
-		return -1, -1, -1
+	p.game.record(Action{Kind: ACTION_PAY_JAIL_FINE, Player: p.token})
+
+	p.money -= p.game.rules.JailFine
+	p.leaveJail()
+	p.game.state = GAME_TURN_START
+	return nil
+}
+
+// canPayJailFine returns an error why p can't pay the fine to get out of jail.
+func (p *Player) canPayJailFine() error {
+	if err := p.checkTurn(GAME_IN_JAIL); err != nil {
+		return err
+	}
+	return p.checkFunds(p.game.rules.JailFine)
+}
+
+// UseJailCard makes p use one of their Get Out of Jail Free cards to get out of jail before rolling
+// the dice. The card goes back to the bottom of its deck and p continues with a normal turn.
+func (p *Player) UseJailCard() error {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.useJailCard()
+}
+
+// useJailCard is like UseJailCard, but expects the game to be locked.
+func (p *Player) useJailCard() error {
+	if err := p.canUseJailCard(); err != nil {
+		return err
+	}
+	p.game.record(Action{Kind: ACTION_USE_JAIL_CARD, Player: p.token})
+
+	c := p.jailCards()[0]
+	delete(p.game.jailCards, c)
+	p.game.deck(c).putBack(c)
+	p.leaveJail()
+	p.game.state = GAME_TURN_START
+	return nil
+}
+
+// canUseJailCard returns an error why p can't use a Get Out of Jail Free card.
+func (p *Player) canUseJailCard() error {
+	if err := p.checkTurn(GAME_IN_JAIL); err != nil {
+		return err
+	}
+	if len(p.jailCards()) == 0 {
+		return ErrNoJailCard
+	}
+	return nil
+}
+
+// leaveJail puts p from jail onto the just visiting field.
+func (p *Player) leaveJail() {
+	p.position = JUST_VISITING
+	p.roundsInJail = 0
+}
+
+// RollDice makes p roll the dice and returns the dice and the field p would land on.
+func (p *Player) RollDice() (int, int, Field, error) {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.rollDice()
+}
+
+// rollDice is like RollDice, but expects the game to be locked.
+func (p *Player) rollDice() (int, int, Field, error) {
+	if err := p.checkTurn(GAME_TURN_START, GAME_IN_JAIL); err != nil {
+		return -1, -1, -1, err
+	}
+	p.game.record(Action{Kind: ACTION_ROLL_DICE, Player: p.token})
+	if p.game.state == GAME_IN_JAIL {
+		d1, d2, f := p.rollDiceInJail()
+		return d1, d2, f, nil
 	}
 
 	d1, d2 := p.game.rollDice()
@@ -228,103 +665,187 @@ func (p *Player) RollDice() (int, int, Field) {
 
 	if d1 == d2 {
 		p.game.doubblesCount++
-		if p.game.doubblesCount == doubblesCountToJail {
-			return d1, d2, IN_JAIL
+		if p.game.doubblesCount == p.game.rules.DoublesToJail {
+			return d1, d2, IN_JAIL, nil
 		}
 	} else {
 		p.game.doubblesCount = 0
 	}
 
-	return d1, d2, Field((int(p.position) + d1 + d2) % numberOfFields)
+	return d1, d2, Field((int(p.position) + d1 + d2) % numberOfFields), nil
 }
 
-func (p *Player) Move() Field {
-	if p.game.state != GAME_ROLLED_DICE {
-		return -1
+// rollDiceInJail rolls the dice for p trying to get out of jail. With doubles p is free and moves
+// forward, but doesn't get another roll. After failing [Rules.MaxRoundsInJail] times, p has to pay the fine
+// and moves forward anyway. Otherwise p stays in jail and the turn is over.
+func (p *Player) rollDiceInJail() (int, int, Field) {
+	d1, d2 := p.game.rollDice()
+	p.game.doubblesCount = 0
+	if d1 != d2 {
+		p.roundsInJail++
+		if p.roundsInJail < p.game.rules.MaxRoundsInJail {
+			p.game.state = GAME_TURN
+			return d1, d2, IN_JAIL
+		}
+		p.pay(p.game.rules.JailFine, nil)
 	}
-	if curr, _ := p.game.GetCurrentPlayer(); curr != p {
-		return -1
+
+	p.leaveJail()
+	p.game.state = GAME_ROLLED_DICE
+	p.game.checkDebts()
+	return d1, d2, Field((int(p.position) + d1 + d2) % numberOfFields)
+}
+
+// Move moves p forward by the last roll of the dice and returns the field p landed on.
+func (p *Player) Move() (Field, error) {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.move()
+}
+
+// move is like Move, but expects the game to be locked.
+func (p *Player) move() (Field, error) {
+	if err := p.checkTurn(GAME_ROLLED_DICE); err != nil {
+		return -1, err
 	}
+	p.game.record(Action{Kind: ACTION_MOVE, Player: p.token})
 
 	d1, d2 := p.game.getLastRoll()
 	p.game.state = GAME_MOVED_TO_NEW_FIELD
-	if d1 == d2 {
-		if p.game.doubblesCount == doubblesCountToJail {
-			p.position = IN_JAIL
-			p.game.doubblesCount = 0
-			return IN_JAIL
-		}
-		if p.position == IN_JAIL {
-			p.position = JUST_VISITING
-		}
+	p.game.drawnCards = nil
+	if d1 == d2 && p.game.doubblesCount == p.game.rules.DoublesToJail {
+		p.goToJail()
+		return p.position, nil
 	}
 
-	p.position = p.position + Field(d1+d2)
-	if p.position >= IN_JAIL {
-		fmt.Printf("Player %s crossed %s\n", p.Token(), GO.Localize(p.game.Language))
-		p.position %= Field(numberOfFields)
-		p.money += moneyOnGo
+	from := p.position
+	p.position = Field((int(from) + d1 + d2) % numberOfFields)
+	p.game.emit(MovedEvent{Player: p, From: from, To: p.position})
+	if p.position < from {
+		p.passGo()
 	}
+	p.land()
+	p.settle()
 
-	if prop, isProp := p.position.Property(); isProp {
-		propOwner, propState, ok := p.game.GetPlayerForProperty(prop)
-		if !ok || propOwner == p {
-			return p.position
-		}
-		rent := prop.GetRentCost(propState)
-		if _, isRR := prop.Railroad(); isRR {
-			rent *= propOwner.Railroads()
-		} else if _, isUtil := prop.Utility(); isUtil {
-			rent = (propOwner.Utilities()*6 - 2) * (d1 + d2)
-		}
-		fmt.Printf("Player %s owes player %s %s for landing on %s\n",
-			p.Token(),
-			propOwner.Token(),
-			p.game.FormatCurrency(rent),
-			prop.Localize(p.game.Language),
-		)
-		p.money -= rent
-		propOwner.money += rent
-	} else {
-		switch p.position {
-		case INCOME_TAX:
-			p.money -= incomeTax
-		case LUXERY_TAX:
-			p.money -= luxeryTax
-		case CHANCE_1, CHANCE_2, CHANCE_3:
-			fmt.Println("Drawing Chance card...")
-		case COMMUNITY_CHEST_1, COMMUNITY_CHEST_2, COMMUNITY_CHEST_3:
-			fmt.Println("Drawing Community Chest card...")
-		case FREE_PARKING:
-			p.money += moneyOnFreeParking
-		case GO_TO_JAIL:
-			fmt.Printf("Player %s went to jail\n", p.Token())
-			p.position = IN_JAIL
+	return p.position, nil
+}
+
+// settle sets the state after p moved to a new field: p has to resolve a drawn card first, then
+// decide about buying an available property. Debts are resolved before anything else.
+func (p *Player) settle() {
+	if p.game.state != GAME_CARD {
+		p.game.state = GAME_MOVED_TO_NEW_FIELD
+		if prop, ok := p.game.board.Property(p.position); ok && p.game.isPropertyAvailable(prop) {
+			p.game.state = GAME_BUY_DECISION
 		}
 	}
+	p.game.checkDebts()
+}
 
-	return p.position
+// passGo pays p the salary for crossing GO.
+func (p *Player) passGo() {
+	p.passedGo = true
+	salary := p.game.rules.MoneyOnGo
+	if p.position == GO && p.game.rules.HouseRules.Has(DOUBLE_SALARY_ON_GO) {
+		salary *= 2
+	}
+	p.money += salary
+	p.game.emit(PassedGoEvent{Player: p, Salary: salary})
 }
 
-// Continue advances the players current turn to the next state.
-func (p *Player) Continue() {
-	if curr, _ := p.game.GetCurrentPlayer(); curr != p {
+// advanceTo moves p forward to the field f. If p crosses GO on the way, they collect the salary.
+func (p *Player) advanceTo(f Field) {
+	from := p.position
+	p.position = f
+	p.game.emit(MovedEvent{Player: p, From: from, To: f})
+	if f < from {
+		p.passGo()
+	}
+}
+
+// goToJail moves p directly to jail, without crossing GO. This also ends p's turn.
+func (p *Player) goToJail() {
+	p.position = IN_JAIL
+	p.roundsInJail = 0
+	p.game.doubblesCount = 0
+	p.game.emit(WentToJailEvent{Player: p})
+}
+
+// payRent makes p pay rent to owner for landing on prop. Nothing happens if no rent is due.
+func (p *Player) payRent(owner *Player, prop Property, rent int) {
+	if rent <= 0 {
 		return
 	}
+	p.game.emit(RentPaidEvent{Player: p, Owner: owner, Property: prop, Amount: rent})
+	p.pay(rent, owner)
+}
 
-	switch p.game.state {
-	case GAME_MOVED_TO_NEW_FIELD:
-		p.game.state = GAME_TURN
+// land applies the effect of the field p is currently standing on.
+func (p *Player) land() {
+	if prop, isProp := p.game.board.Property(p.position); isProp {
+		propOwner, propState, ok := p.game.playerForProperty(prop)
+		if !ok || propOwner == p {
+			return
+		}
+		d1, d2 := p.game.getLastRoll()
+		p.payRent(propOwner, prop, rentFor(propOwner, prop, propState, d1+d2, 0))
+		return
+	}
+
+	switch p.game.board.Kind(p.position) {
+	case KIND_TAX:
+		tax := p.game.board.Tax(p.position)
+		p.game.emit(TaxPaidEvent{Player: p, Field: p.position, Amount: tax})
+		p.payFee(tax)
+	case KIND_CHANCE:
+		p.drawCard(&p.game.chance)
+	case KIND_COMMUNITY_CHEST:
+		p.drawCard(&p.game.communityChest)
+	case KIND_FREE_PARKING:
+		p.money += p.game.jackpot
+		p.game.jackpot = 0
+	case KIND_GO_TO_JAIL:
+		p.goToJail()
 	}
 }
 
-func (p *Player) EndTurn() (again bool) {
-	if p.game.state != GAME_TURN {
-		return false
+// Continue advances the players current turn to the next state. After drawing a card, it resolves
+// the card.
+func (p *Player) Continue() error {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.continueTurn()
+}
+
+// continueTurn is like Continue, but expects the game to be locked.
+func (p *Player) continueTurn() error {
+	if err := p.checkTurn(GAME_MOVED_TO_NEW_FIELD, GAME_CARD); err != nil {
+		return err
+	}
+	p.game.record(Action{Kind: ACTION_CONTINUE, Player: p.token})
+
+	if p.game.state == GAME_CARD {
+		p.resolveCard()
+		p.settle()
+		return nil
 	}
-	if curr, _ := p.game.GetCurrentPlayer(); curr != p {
-		return false
+	p.game.state = GAME_TURN
+	return nil
+}
+
+// EndTurn ends p's turn and reports whether p may roll again after doubles.
+func (p *Player) EndTurn() (again bool, err error) {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.endTurn()
+}
+
+// endTurn is like EndTurn, but expects the game to be locked.
+func (p *Player) endTurn() (again bool, err error) {
+	if err := p.checkTurn(GAME_TURN); err != nil {
+		return false, err
 	}
+	p.game.record(Action{Kind: ACTION_END_TURN, Player: p.token})
 
 	if p.game.doubblesCount == 0 {
 		p.game.nextPlayer()
@@ -332,6 +853,6 @@ func (p *Player) EndTurn() (again bool) {
 	} else {
 		again = true
 	}
-	p.game.state = GAME_TURN_START
-	return again
+	p.game.beginTurn()
+	return again, nil
 }
@@ -0,0 +1,121 @@
+package monopoly
+
+import (
+	crand "crypto/rand"
+	"math/big"
+	"math/rand"
+)
+
+// RandomSource provides all randomness of a game: rolling the dice, choosing the first player and
+// shuffling the decks. Set [Rules.Random] to choose the source a game uses.
+type RandomSource interface {
+	// Intn returns a random number in the interval [0,n). It panics if n <= 0.
+	Intn(n int) int
+}
+
+// SeededSource is a pseudo random source. Two sources with the same seed produce the same
+// sequence, so a game played with it can be reproduced by its seed.
+type SeededSource struct {
+	seed int64
+	src  *countingSource
+	r    *rand.Rand
+}
+
+// countingSource counts the values taken from the underlying source, so a [SeededSource] can be
+// restored to the same position of its sequence.
+type countingSource struct {
+	rand.Source
+	n uint64
+}
+
+func (s *countingSource) Int63() int64 {
+	s.n++
+	return s.Source.Int63()
+}
+
+// NewSeededSource returns a pseudo random source seeded with seed.
+func NewSeededSource(seed int64) *SeededSource {
+	src := &countingSource{Source: rand.NewSource(seed)}
+	return &SeededSource{
+		seed: seed,
+		src:  src,
+		r:    rand.New(src),
+	}
+}
+
+// restoreSeededSource returns a source seeded with seed, which already produced draws values.
+func restoreSeededSource(seed int64, draws uint64) *SeededSource {
+	s := NewSeededSource(seed)
+	for s.src.n < draws {
+		s.src.Int63()
+	}
+	return s
+}
+
+// Intn implements [RandomSource] interface.
+func (s *SeededSource) Intn(n int) int {
+	return s.r.Intn(n)
+}
+
+// Seed returns the seed s was created with.
+func (s *SeededSource) Seed() int64 {
+	return s.seed
+}
+
+// FixedSource returns a fixed sequence of values. It is meant for tests and for replaying a game.
+type FixedSource struct {
+	values []int
+	next   int
+}
+
+// NewFixedSource returns a source which returns values in order. Every value is taken modulo the n
+// passed to Intn. After the last value it starts over with the first one.
+func NewFixedSource(values ...int) *FixedSource {
+	return &FixedSource{values: values}
+}
+
+// NewFixedDice returns a source which rolls the given dice in order, e.g. NewFixedDice(3, 4, 6, 6)
+// rolls [3] [4] and then doubles of 6. Every use of the source takes a value: passed to [NewGame]
+// with [Rules.Random], choosing the first player takes one value and shuffling each deck one less
+// than its number of cards, so the dice only roll in order when the source is set after the game
+// was created.
+func NewFixedDice(dice ...int) *FixedSource {
+	values := make([]int, len(dice))
+	for i, d := range dice {
+		values[i] = d - 1
+	}
+	return NewFixedSource(values...)
+}
+
+// Intn implements [RandomSource] interface.
+func (s *FixedSource) Intn(n int) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+	if len(s.values) == 0 {
+		return 0
+	}
+	v := s.values[s.next] % n
+	s.next = (s.next + 1) % len(s.values)
+	return v
+}
+
+// CryptoSource is a cryptographically secure random source for online games, where the players
+// must not be able to predict the dice.
+type CryptoSource struct{}
+
+// Intn implements [RandomSource] interface.
+func (CryptoSource) Intn(n int) int {
+	v, err := crand.Int(crand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		panic(err)
+	}
+	return int(v.Int64())
+}
+
+// shuffle shuffles the first n elements using swap in a random order from r.
+func shuffle(r RandomSource, n int, swap func(i, j int)) {
+	for i := n - 1; i > 0; i-- {
+		swap(i, r.Intn(i+1))
+	}
+}
@@ -0,0 +1,46 @@
+package monopoly
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewFixedDice(t *testing.T) {
+	r := NewFixedDice(3, 4, 6, 6)
+	for _, want := range [][2]int{{3, 4}, {6, 6}, {3, 4}} {
+		if d1, d2 := RollDice(r); d1 != want[0] || d2 != want[1] {
+			t.Errorf("RollDice() got = (%d, %d), want = (%d, %d)", d1, d2, want[0], want[1])
+		}
+	}
+}
+
+func TestNewGame_seeded(t *testing.T) {
+	newGame := func(seed int64) *Game {
+		rules := DefaultRules()
+		rules.Random = NewSeededSource(seed)
+		return NewGame(rules, CAR, DOG, HAT, SHIP)
+	}
+	g1, g2 := newGame(42), newGame(42)
+	if g1.currentTurn != g2.currentTurn || !reflect.DeepEqual(g1.chance, g2.chance) || !reflect.DeepEqual(g1.communityChest, g2.communityChest) {
+		t.Fatal("games with the same seed got different first players or decks")
+	}
+	for i := 0; i < 20; i++ {
+		g1.rollDice()
+		g2.rollDice()
+		if g1.lastRoll != g2.lastRoll {
+			t.Fatalf("roll %d of games with the same seed differ", i)
+		}
+	}
+	if seed, ok := g1.Seed(); !ok || seed != 42 {
+		t.Errorf("Game.Seed() got = (%d, %t), want (42, true)", seed, ok)
+	}
+}
+
+func TestCryptoSource(t *testing.T) {
+	var r CryptoSource
+	for i := 0; i < 50; i++ {
+		if d1, d2 := RollDice(r); d1 < 1 || d1 > 6 || d2 < 1 || d2 > 6 {
+			t.Errorf("RollDice() got = (%d, %d), want dice between 1 and 6", d1, d2)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package monopoly
+
+// rentFor returns the rent owner collects for prop in the state ps, taking all of owner's holdings
+// into account. diceSum is the sum of the dice thrown by the player who landed on prop and is only
+// used for utilities. utilityFactor, if not 0, is the factor of the dice for utilities regardless of
+// owner's holdings, like with [CHANCE_ADVANCE_TO_NEAREST_UTILITY].
+//
+//   - Streets have their rent doubled while unimproved, when owner has the whole color group.
+//   - Railroads cost 25, 50, 100 or 200 depending on how many unmortgaged railroads owner has.
+//   - Utilities cost 4 times the dice, or 10 times the dice when owner has all of them unmortgaged.
+//
+// With the [NO_RENT_IN_JAIL] house rule, owner collects no rent while sitting in jail.
+func rentFor(owner *Player, prop Property, ps PropertyState, diceSum, utilityFactor int) int {
+	if ps == STATE_MORTGAGE {
+		return 0
+	}
+	if owner.position == IN_JAIL && owner.game.rules.HouseRules.Has(NO_RENT_IN_JAIL) {
+		return 0
+	}
+
+	board := owner.game.board
+	switch board.Kind(Field(prop)) {
+	case KIND_RAILROAD:
+		return board.Rent(prop, ps) << (owner.railroads() - 1)
+	case KIND_UTILITY:
+		if utilityFactor != 0 {
+			return utilityFactor * diceSum
+		}
+		if owner.utilities() == len(board.fieldsOfKind(KIND_UTILITY)) {
+			return 10 * diceSum
+		}
+		return 4 * diceSum
+	}
+
+	rent := board.Rent(prop, ps)
+	if cg, ok := board.ColorGroup(prop); ok && ps == STATE_NORMAL && owner.hasMonopoly(cg) {
+		rent *= 2
+	}
+	return rent
+}
+
+// RentAt returns the rent p would have to pay when landing on the field f with a dice roll of
+// diceSum. It is 0 for fields that are no properties, properties nobody or p themselves owns and
+// mortgaged properties.
+func (p *Player) RentAt(f Field, diceSum int) int {
+	p.game.lock()
+	defer p.game.unlock()
+	prop, isProp := p.game.board.Property(f)
+	if !isProp {
+		return 0
+	}
+	owner, state, ok := p.game.playerForProperty(prop)
+	if !ok || owner == p {
+		return 0
+	}
+	return rentFor(owner, prop, state, diceSum, 0)
+}
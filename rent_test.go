@@ -0,0 +1,42 @@
+package monopoly
+
+import "testing"
+
+func TestPlayer_RentAt(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG)
+	p, owner := g.players[0], g.players[1]
+
+	tests := []struct {
+		name      string
+		inventory Inventory
+		field     Field
+		want      int
+	}{
+		{"unowned", Inventory{}, Field(BOARDWALK), 0},
+		{"single street", Inventory{BOARDWALK: STATE_NORMAL}, Field(BOARDWALK), 50},
+		{"complete group", Inventory{BOARDWALK: STATE_NORMAL, PARK_PLACE: STATE_NORMAL}, Field(BOARDWALK), 100},
+		{"complete group with houses", Inventory{BOARDWALK: STATE_HOUSE_1, PARK_PLACE: STATE_HOUSE_1}, Field(BOARDWALK), 200},
+		{"mortgaged", Inventory{BOARDWALK: STATE_MORTGAGE, PARK_PLACE: STATE_NORMAL}, Field(BOARDWALK), 0},
+		{"1 railroad", Inventory{Property(SHORT_LINE): STATE_NORMAL}, Field(SHORT_LINE), 25},
+		{"2 railroads", Inventory{Property(SHORT_LINE): STATE_NORMAL, Property(READING_RAILROAD): STATE_NORMAL}, Field(SHORT_LINE), 50},
+		{"3 railroads, 1 mortgaged", Inventory{
+			Property(SHORT_LINE):            STATE_NORMAL,
+			Property(READING_RAILROAD):      STATE_NORMAL,
+			Property(PENNSYLVANIA_RAILROAD): STATE_MORTGAGE,
+		}, Field(SHORT_LINE), 50},
+		{"4 railroads", Inventory{
+			Property(SHORT_LINE):              STATE_NORMAL,
+			Property(READING_RAILROAD):        STATE_NORMAL,
+			Property(PENNSYLVANIA_RAILROAD):   STATE_NORMAL,
+			Property(BALTIMORE_OHIO_RAILROAD): STATE_NORMAL,
+		}, Field(SHORT_LINE), 200},
+		{"1 utility", Inventory{Property(WATER_WORKS): STATE_NORMAL}, Field(WATER_WORKS), 4 * 7},
+		{"2 utilities", Inventory{Property(WATER_WORKS): STATE_NORMAL, Property(ELECTRIC_COMPANY): STATE_NORMAL}, Field(WATER_WORKS), 10 * 7},
+	}
+	for _, tt := range tests {
+		owner.inventory = tt.inventory
+		if got := p.RentAt(tt.field, 7); got != tt.want {
+			t.Errorf("%s: Player.RentAt(%#v) got = %d, want %d", tt.name, tt.field, got, tt.want)
+		}
+	}
+}
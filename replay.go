@@ -0,0 +1,142 @@
+package monopoly
+
+import "fmt"
+
+// Replay replays the actions of an [ActionLog] step by step. Every step applies a single action
+// with the random values recorded for it, so the replayed game ends in the identical state.
+type Replay struct {
+	log    *ActionLog
+	game   *Game
+	source *playbackSource
+	step   int
+}
+
+// NewReplay returns a replay of log, positioned before the first action.
+func NewReplay(log *ActionLog) (*Replay, error) {
+	r := &Replay{log: log.clone()}
+	if err := r.reset(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// reset sets up a new game from the log, positioned before the first action.
+func (r *Replay) reset() error {
+	r.source = &playbackSource{draws: append([]int(nil), r.log.Setup...)}
+	rules := r.log.Rules
+	rules.Random = r.source
+	g := NewGame(rules, r.log.Players...)
+	if g == nil {
+		return fmt.Errorf("%w: %d players", ErrReplayDiverged, len(r.log.Players))
+	}
+	if err := r.source.check(); err != nil {
+		return err
+	}
+	r.game = g
+	r.step = 0
+	return nil
+}
+
+// Game returns the game at the current step. It must not be modified, otherwise the following
+// steps may fail.
+func (r *Replay) Game() *Game {
+	return r.game
+}
+
+// Step returns the number of actions applied so far.
+func (r *Replay) Step() int {
+	return r.step
+}
+
+// Len returns the number of actions in the replay.
+func (r *Replay) Len() int {
+	return len(r.log.Actions)
+}
+
+// Action returns the action that is applied by the next call to [Replay.Forward] and reports
+// whether there is one.
+func (r *Replay) Action() (Action, bool) {
+	if r.step >= len(r.log.Actions) {
+		return Action{}, false
+	}
+	return r.log.Actions[r.step].Action, true
+}
+
+// Forward applies the next action.
+func (r *Replay) Forward() error {
+	if r.step >= len(r.log.Actions) {
+		return ErrReplayEnd
+	}
+	e := r.log.Actions[r.step]
+	r.source.draws = append(r.source.draws, e.Draws...)
+	r.game.lock()
+	err := r.game.apply(e.Action)
+	r.game.unlock()
+	if err != nil {
+		return fmt.Errorf("%w: step %d (%#v): %w", ErrReplayDiverged, r.step, e.Kind, err)
+	}
+	if err := r.source.check(); err != nil {
+		return fmt.Errorf("step %d (%#v): %w", r.step, e.Kind, err)
+	}
+	r.step++
+	return nil
+}
+
+// Backward reverts the last applied action. The game is replayed from the start, so the game
+// returned by [Replay.Game] changes.
+func (r *Replay) Backward() error {
+	if r.step == 0 {
+		return ErrReplayStart
+	}
+	return r.Seek(r.step - 1)
+}
+
+// Seek moves the replay to the point after step actions were applied.
+func (r *Replay) Seek(step int) error {
+	if step < 0 {
+		return ErrReplayStart
+	}
+	if step > len(r.log.Actions) {
+		return ErrReplayEnd
+	}
+	if step < r.step {
+		if err := r.reset(); err != nil {
+			return err
+		}
+	}
+	for r.step < step {
+		if err := r.Forward(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// playbackSource returns recorded random values in order.
+type playbackSource struct {
+	draws []int
+	err   error
+}
+
+// Intn implements [RandomSource] interface.
+func (s *playbackSource) Intn(n int) int {
+	if len(s.draws) == 0 {
+		s.err = fmt.Errorf("%w: more random values drawn than recorded", ErrReplayDiverged)
+		return 0
+	}
+	v := s.draws[0]
+	s.draws = s.draws[1:]
+	if v < 0 || v >= n {
+		s.err = fmt.Errorf("%w: recorded random value %d is out of range [0,%d)", ErrReplayDiverged, v, n)
+		return 0
+	}
+	return v
+}
+
+// check returns an error if the values drawn so far didn't match the recorded ones.
+func (s *playbackSource) check() error {
+	if s.err == nil && len(s.draws) != 0 {
+		s.err = fmt.Errorf("%w: %d recorded random values were not drawn", ErrReplayDiverged, len(s.draws))
+	}
+	return s.err
+}
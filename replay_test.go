@@ -0,0 +1,122 @@
+package monopoly
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// playTurns plays n actions of g, buying every property and building wherever possible.
+func playTurns(t *testing.T, g *Game, n int) {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		p, state := g.GetCurrentPlayer()
+		var err error
+		switch state {
+		case GAME_TURN_START, GAME_IN_JAIL:
+			_, _, _, err = p.RollDice()
+		case GAME_ROLLED_DICE:
+			_, err = p.Move()
+		case GAME_MOVED_TO_NEW_FIELD, GAME_CARD:
+			err = p.Continue()
+		case GAME_BUY_DECISION:
+			if _, err = p.BuyProperty(); err != nil {
+				err = p.DeclineProperty()
+			}
+		case GAME_AUCTION:
+			a, _ := g.Auction()
+			for _, bidder := range g.Players() {
+				if _, highest := a.HighestBid(); bidder != highest && !a.Passed(bidder) {
+					bidder.PassAuction()
+				}
+			}
+		case GAME_DEBT:
+			for _, debtor := range g.Debtors() {
+				if debtor.PayDebts() != nil {
+					debtor.DeclareBankruptcy()
+				}
+			}
+		case GAME_TURN:
+			for prop := range p.inventory {
+				p.BuyHouse(prop)
+			}
+			_, err = p.EndTurn()
+		case GAME_OVER:
+			return
+		}
+		if err != nil {
+			t.Fatalf("action %d in state %#v got error: %v", i, state, err)
+		}
+	}
+}
+
+func TestReplay(t *testing.T) {
+	rules := DefaultRules()
+	rules.Random = NewSeededSource(3)
+	g := NewGame(rules, CAR, DOG, HAT)
+	playTurns(t, g, 300)
+
+	log, ok := g.Log()
+	if !ok || len(log.Actions) == 0 {
+		t.Fatal("Game.Log() got no actions")
+	}
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(log); err != nil {
+		t.Fatalf("encoding log got error: %v", err)
+	}
+	var decoded ActionLog
+	if err := json.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Fatalf("decoding log got error: %v", err)
+	}
+
+	r, err := NewReplay(&decoded)
+	if err != nil {
+		t.Fatalf("NewReplay() got error: %v", err)
+	}
+	if err := r.Seek(r.Len()); err != nil {
+		t.Fatalf("Replay.Seek(%d) got error: %v", r.Len(), err)
+	}
+	assertSameGame(t, r.Game(), g)
+
+	mid := r.Len() / 2
+	want, _ := NewReplay(&decoded)
+	if err := want.Seek(mid + 1); err != nil {
+		t.Fatalf("Replay.Seek(%d) got error: %v", mid+1, err)
+	}
+	if err := r.Seek(mid); err != nil {
+		t.Fatalf("Replay.Seek(%d) got error: %v", mid, err)
+	}
+	if err := r.Backward(); err != nil {
+		t.Fatalf("Replay.Backward() got error: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := r.Forward(); err != nil {
+			t.Fatalf("Replay.Forward() got error: %v", err)
+		}
+	}
+	if r.Step() != mid+1 {
+		t.Errorf("Replay after stepping back and forth is at step %d, want %d", r.Step(), mid+1)
+	}
+	assertSameGame(t, r.Game(), want.Game())
+	if err := r.Seek(0); err != nil || r.Backward() != ErrReplayStart {
+		t.Errorf("Replay.Backward() at the start got no %v", ErrReplayStart)
+	}
+}
+
+// assertSameGame fails if got and want are in a different state.
+func assertSameGame(t *testing.T, got, want *Game) {
+	t.Helper()
+	if got.state != want.state || got.currentTurn != want.currentTurn || got.lastRoll != want.lastRoll {
+		t.Fatalf("game is in state %#v on turn %d, want %#v on turn %d", got.state, got.currentTurn, want.state, want.currentTurn)
+	}
+	if !reflect.DeepEqual(got.chance, want.chance) || !reflect.DeepEqual(got.communityChest, want.communityChest) {
+		t.Error("games have different decks")
+	}
+	for i, wp := range want.players {
+		gp := got.players[i]
+		if gp.token != wp.token || gp.position != wp.position || gp.money != wp.money || gp.bankrupt != wp.bankrupt || !reflect.DeepEqual(gp.inventory, wp.inventory) {
+			t.Errorf("player %d got %#v, want %#v", i, gp, wp)
+		}
+	}
+}
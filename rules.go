@@ -1,11 +1,54 @@
 package monopoly
 
-const (
-	numberOfFields      = int(IN_JAIL)
-	startMoney          = 5000
-	doubblesCountToJail = 4
-	moneyOnGo           = 200
-	moneyOnFreeParking  = 0
-	incomeTax           = moneyOnGo
-	luxeryTax           = 75
-)
+const numberOfFields = int(IN_JAIL)
+
+// Rules is the set of settings a game is played with. Use [DefaultRules] to get the official rules
+// and change single settings from there.
+type Rules struct {
+	// Board is the board to play on. If nil, the classic board is used.
+	Board *Board `json:"board"`
+	// StartMoney is the amount of money every player has at the start of the game.
+	StartMoney int `json:"start_money"`
+	// DoublesToJail is the number of doubles in a row that send a player directly to jail.
+	DoublesToJail int `json:"doubles_to_jail"`
+	// MoneyOnGo is the salary a player collects when crossing or landing on GO.
+	MoneyOnGo int `json:"money_on_go"`
+	// JailFine is the amount of money to pay for getting out of jail.
+	JailFine int `json:"jail_fine"`
+	// MaxRoundsInJail is the number of tries a player has to roll doubles in jail, before they have
+	// to pay the fine.
+	MaxRoundsInJail int `json:"max_rounds_in_jail"`
+	// MortgageInterest is the interest in percent to pay on top of the mortgage value, when lifting
+	// a mortgage.
+	MortgageInterest int `json:"mortgage_interest"`
+	// AuctionMinIncrement is the minimum amount of money a bid in an auction must raise the highest
+	// bid by.
+	AuctionMinIncrement int `json:"auction_min_increment"`
+	// Houses is the number of houses the bank has at the start of the game.
+	Houses int `json:"houses"`
+	// Hotels is the number of hotels the bank has at the start of the game.
+	Hotels int `json:"hotels"`
+	// HouseRules are the optional house rules played in addition.
+	HouseRules HouseRule `json:"house_rules"`
+	// Random is the source of all randomness in the game. If nil, a [SeededSource] with a random
+	// seed is used.
+	Random RandomSource `json:"-"`
+	// Undo configures which actions can be undone. By default undo is disabled.
+	Undo UndoPolicy `json:"undo"`
+}
+
+// DefaultRules returns the rules of the official game.
+func DefaultRules() Rules {
+	return Rules{
+		Board:               DefaultBoard(),
+		StartMoney:          1500,
+		DoublesToJail:       3,
+		MoneyOnGo:           200,
+		JailFine:            50,
+		MaxRoundsInJail:     3,
+		MortgageInterest:    10,
+		AuctionMinIncrement: 10,
+		Houses:              32,
+		Hotels:              12,
+	}
+}
@@ -0,0 +1,402 @@
+package monopoly
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// saveVersion is the version of the save format written by [Game.Save]. It has to be increased
+// with every incompatible change of the format.
+const saveVersion = 1
+
+// savedGame is the serializable form of a [Game]. Players are referenced by their token.
+type savedGame struct {
+	Version        int            `json:"version"`
+	Language       language.Tag   `json:"language"`
+	Rules          Rules          `json:"rules"`
+	Random         *savedRandom   `json:"random,omitempty"`
+	Players        []savedPlayer  `json:"players"`
+	CurrentTurn    int            `json:"current_turn"`
+	Dice           [2]int         `json:"dice"`
+	Doubles        int            `json:"doubles"`
+	State          GameState      `json:"state"`
+	ResumeState    GameState      `json:"resume_state"`
+	Chance         Deck           `json:"chance"`
+	CommunityChest Deck           `json:"community_chest"`
+	DrawnCards     []Card         `json:"drawn_cards,omitempty"`
+	JailCards      map[Card]Token `json:"jail_cards,omitempty"`
+	Auction        *savedAuction  `json:"auction,omitempty"`
+	Trade          *savedTrade    `json:"trade,omitempty"`
+	Houses         int            `json:"houses"`
+	Hotels         int            `json:"hotels"`
+	Jackpot        int            `json:"jackpot"`
+}
+
+// savedRandom is the state of a [SeededSource].
+type savedRandom struct {
+	Seed  int64  `json:"seed"`
+	Draws uint64 `json:"draws"`
+}
+
+type savedPlayer struct {
+	Token        Token       `json:"token"`
+	Position     Field       `json:"position"`
+	Money        int         `json:"money"`
+	Inventory    Inventory   `json:"inventory"`
+	RoundsInJail int         `json:"rounds_in_jail"`
+	Debts        []savedDebt `json:"debts,omitempty"`
+	Bankrupt     bool        `json:"bankrupt"`
+	Reserved     [2]int      `json:"reserved"`
+	PassedGo     bool        `json:"passed_go"`
+}
+
+type savedDebt struct {
+	Creditor *Token `json:"creditor"` // nil if the money is owed to the bank
+	Amount   int    `json:"amount"`
+	Fee      bool   `json:"fee"`
+}
+
+type savedAuction struct {
+	Property      Property       `json:"property"`
+	Building      Building       `json:"building"`
+	Resume        GameState      `json:"resume"`
+	MinIncrement  int            `json:"min_increment"`
+	HighestBid    int            `json:"highest_bid"`
+	HighestBidder *Token         `json:"highest_bidder"`
+	Passed        map[Token]bool `json:"passed,omitempty"`
+}
+
+type savedTrade struct {
+	From  Token `json:"from"`
+	To    Token `json:"to"`
+	Trade Trade `json:"trade"`
+}
+
+// Save writes the complete state of g to w in JSON format. The game can be restored with
+// [LoadGame]. Subscribers are not saved.
+func (g *Game) Save(w io.Writer) error {
+	return json.NewEncoder(w).Encode(g)
+}
+
+// LoadGame reads a game saved with [Game.Save] from r. When the game was played with a
+// [SeededSource], the source continues where it stopped. Otherwise the loaded game uses a new
+// randomly seeded source.
+func LoadGame(r io.Reader) (*Game, error) {
+	g := &Game{}
+	if err := json.NewDecoder(r).Decode(g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// MarshalJSON implements [json.Marshaler] interface.
+func (g *Game) MarshalJSON() ([]byte, error) {
+	g.lock()
+	defer g.unlock()
+	d1, d2 := g.getLastRoll()
+	s := savedGame{
+		Version:        saveVersion,
+		Language:       g.Language,
+		Rules:          g.rules,
+		CurrentTurn:    g.currentTurn,
+		Dice:           [2]int{d1, d2},
+		Doubles:        g.doubblesCount,
+		State:          g.state,
+		ResumeState:    g.resumeState,
+		Chance:         g.chance,
+		CommunityChest: g.communityChest,
+		DrawnCards:     g.drawnCards,
+		JailCards:      map[Card]Token{},
+		Houses:         g.houses,
+		Hotels:         g.hotels,
+		Jackpot:        g.jackpot,
+	}
+	if src, ok := g.random.(*SeededSource); ok {
+		s.Random = &savedRandom{Seed: src.seed, Draws: src.src.n}
+	}
+
+	for _, p := range g.players {
+		sp := savedPlayer{
+			Token:        p.token,
+			Position:     p.position,
+			Money:        p.money,
+			Inventory:    Inventory{},
+			RoundsInJail: p.roundsInJail,
+			Bankrupt:     p.bankrupt,
+			Reserved:     p.reserved,
+			PassedGo:     p.passedGo,
+		}
+		for prop, state := range p.inventory {
+			sp.Inventory[prop] = state
+		}
+		for _, d := range p.debts {
+			sp.Debts = append(sp.Debts, savedDebt{Creditor: tokenOf(d.creditor), Amount: d.amount, Fee: d.fee})
+		}
+		s.Players = append(s.Players, sp)
+	}
+	for c, p := range g.jailCards {
+		s.JailCards[c] = p.token
+	}
+
+	if a := g.auction; a != nil {
+		s.Auction = &savedAuction{
+			Property:      a.property,
+			Building:      a.building,
+			Resume:        a.resume,
+			MinIncrement:  a.minIncrement,
+			HighestBid:    a.highestBid,
+			HighestBidder: tokenOf(a.highestBidder),
+			Passed:        map[Token]bool{},
+		}
+		for p, passed := range a.passed {
+			s.Auction.Passed[p.token] = passed
+		}
+	}
+	if tp := g.trade; tp != nil {
+		s.Trade = &savedTrade{From: tp.From.token, To: tp.To.token, Trade: tp.Trade}
+	}
+	return json.Marshal(s)
+}
+
+// UnmarshalJSON implements [json.Unmarshaler] interface.
+func (g *Game) UnmarshalJSON(data []byte) error {
+	var s savedGame
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s.Version != saveVersion {
+		return fmt.Errorf("%w: got %d, want %d", ErrUnsupportedVersion, s.Version, saveVersion)
+	}
+	if len(s.Players) < 2 || s.CurrentTurn < 0 || s.CurrentTurn >= len(s.Players) {
+		return fmt.Errorf("%w: %d players with current turn %d", ErrInvalidSave, len(s.Players), s.CurrentTurn)
+	}
+	if s.Rules.Board == nil {
+		s.Rules.Board = DefaultBoard()
+	} else if err := s.Rules.Board.Validate(); err != nil {
+		return err
+	}
+	if err := s.validate(); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSave, err)
+	}
+	if s.Random != nil {
+		s.Rules.Random = restoreSeededSource(s.Random.Seed, s.Random.Draws)
+	} else {
+		s.Rules.Random = NewSeededSource(time.Now().UnixNano())
+	}
+
+	loaded := &Game{
+		Language:       s.Language,
+		rules:          s.Rules,
+		board:          s.Rules.Board,
+		random:         s.Rules.Random,
+		currentTurn:    s.CurrentTurn,
+		doubblesCount:  s.Doubles,
+		state:          s.State,
+		resumeState:    s.ResumeState,
+		chance:         s.Chance,
+		communityChest: s.CommunityChest,
+		drawnCards:     s.DrawnCards,
+		jailCards:      map[Card]*Player{},
+		houses:         s.Houses,
+		hotels:         s.Hotels,
+		jackpot:        s.Jackpot,
+	}
+	loaded.setLastRoll(s.Dice[0], s.Dice[1])
+
+	players := map[Token]*Player{}
+	for _, sp := range s.Players {
+		if _, ok := players[sp.Token]; ok {
+			return fmt.Errorf("%w: token %#v used twice", ErrInvalidSave, sp.Token)
+		}
+		p := &Player{
+			game:         g,
+			token:        sp.Token,
+			position:     sp.Position,
+			money:        sp.Money,
+			inventory:    sp.Inventory,
+			roundsInJail: sp.RoundsInJail,
+			bankrupt:     sp.Bankrupt,
+			reserved:     sp.Reserved,
+			passedGo:     sp.PassedGo,
+		}
+		if p.inventory == nil {
+			p.inventory = Inventory{}
+		}
+		players[p.token] = p
+		loaded.players = append(loaded.players, p)
+	}
+	player := func(t *Token) (*Player, error) {
+		if t == nil {
+			return nil, nil
+		}
+		p, ok := players[*t]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown player %#v", ErrInvalidSave, *t)
+		}
+		return p, nil
+	}
+
+	var err error
+	for i, sp := range s.Players {
+		p := loaded.players[i]
+		for _, sd := range sp.Debts {
+			d := debt{amount: sd.Amount, fee: sd.Fee}
+			if d.creditor, err = player(sd.Creditor); err != nil {
+				return err
+			}
+			p.debts = append(p.debts, d)
+		}
+	}
+	for c, t := range s.JailCards {
+		if loaded.jailCards[c], err = player(&t); err != nil {
+			return err
+		}
+	}
+	if sa := s.Auction; sa != nil {
+		a := &Auction{
+			property:     sa.Property,
+			building:     sa.Building,
+			resume:       sa.Resume,
+			minIncrement: sa.MinIncrement,
+			highestBid:   sa.HighestBid,
+			passed:       map[*Player]bool{},
+		}
+		if a.highestBidder, err = player(sa.HighestBidder); err != nil {
+			return err
+		}
+		for t, passed := range sa.Passed {
+			p, err := player(&t)
+			if err != nil {
+				return err
+			}
+			a.passed[p] = passed
+		}
+		loaded.auction = a
+	}
+	if st := s.Trade; st != nil {
+		tp := &TradeProposal{Trade: st.Trade}
+		if tp.From, err = player(&st.From); err != nil {
+			return err
+		}
+		if tp.To, err = player(&st.To); err != nil {
+			return err
+		}
+		loaded.trade = tp
+	}
+
+	g.lock()
+	defer g.unlock()
+	g.copyState(loaded)
+	g.log, g.undo, g.redo = nil, nil, nil
+	return nil
+}
+
+// validate checks that the values of s fit its board, so that the loaded game can't end up in an
+// impossible position. The references between players are checked while loading.
+func (s *savedGame) validate() error {
+	board := s.Rules.Board
+	states := allGameStates()
+	if !slices.Contains(states, s.State) {
+		return fmt.Errorf("unknown state %d", s.State)
+	}
+	if !slices.Contains(states, s.ResumeState) {
+		return fmt.Errorf("unknown resume state %d", s.ResumeState)
+	}
+	if s.State == GAME_AUCTION && s.Auction == nil {
+		return fmt.Errorf("state %s without an auction", s.State)
+	}
+	if s.State == GAME_TRADE && s.Trade == nil {
+		return fmt.Errorf("state %s without a trade", s.State)
+	}
+	if s.State == GAME_CARD && len(s.DrawnCards) == 0 {
+		return fmt.Errorf("state %s without a drawn card", s.State)
+	}
+	// the game ends without passing the turn, when the current player goes bankrupt
+	if s.State != GAME_OVER && s.Players[s.CurrentTurn].Bankrupt {
+		return fmt.Errorf("current player %#v is bankrupt", s.Players[s.CurrentTurn].Token)
+	}
+	if err := validateDeck(s.Chance, Card.IsChance); err != nil {
+		return fmt.Errorf("chance deck: %w", err)
+	}
+	if err := validateDeck(s.CommunityChest, Card.IsCommunityChest); err != nil {
+		return fmt.Errorf("community chest deck: %w", err)
+	}
+	for _, c := range s.DrawnCards {
+		if !c.IsChance() && !c.IsCommunityChest() {
+			return fmt.Errorf("unknown drawn card %d", c)
+		}
+	}
+	for c := range s.JailCards {
+		if !c.IsGetOutOfJailFree() {
+			return fmt.Errorf("%s is held like a Get Out of Jail Free card", c)
+		}
+	}
+	if s.Dice[0] < 0 || s.Dice[0] > 6 || s.Dice[1] < 0 || s.Dice[1] > 6 {
+		return fmt.Errorf("dice %d and %d", s.Dice[0], s.Dice[1])
+	}
+
+	owners := map[Property]Token{}
+	for _, sp := range s.Players {
+		if sp.Position < 0 || int(sp.Position) >= len(board.Fields) && sp.Position != IN_JAIL {
+			return fmt.Errorf("%#v is on field %d of %d", sp.Token, sp.Position, len(board.Fields))
+		}
+		for prop, state := range sp.Inventory {
+			if _, ok := board.Property(Field(prop)); !ok {
+				return fmt.Errorf("%#v owns %d, which is not a property", sp.Token, prop)
+			}
+			if owner, ok := owners[prop]; ok {
+				return fmt.Errorf("%s is owned by %#v and %#v", board.Localize(Field(prop), language.English), owner, sp.Token)
+			}
+			owners[prop] = sp.Token
+			if state < STATE_MORTGAGE || state > STATE_HOTEL || state > STATE_NORMAL && board.Kind(Field(prop)) != KIND_STREET {
+				return fmt.Errorf("%s can't have state %d", board.Localize(Field(prop), language.English), state)
+			}
+		}
+	}
+	if a := s.Auction; a != nil {
+		switch {
+		case a.Building != -1 && a.Building != HOUSE && a.Building != HOTEL:
+			return fmt.Errorf("auction of unknown building %d", a.Building)
+		case (a.Property == -1) == (a.Building == -1):
+			return fmt.Errorf("auction of property %d and building %d", a.Property, a.Building)
+		case a.Property != -1:
+			if _, ok := board.Property(Field(a.Property)); !ok {
+				return fmt.Errorf("auction of %d, which is not a property", a.Property)
+			}
+		}
+	}
+	return nil
+}
+
+// validateDeck checks that deck is not empty and contains each card at most once, all of them
+// belonging to the deck according to belongs.
+func validateDeck(deck Deck, belongs func(Card) bool) error {
+	if len(deck) == 0 {
+		return errors.New("no cards")
+	}
+	seen := map[Card]bool{}
+	for _, c := range deck {
+		if !belongs(c) {
+			return fmt.Errorf("contains %d", c)
+		}
+		if seen[c] {
+			return fmt.Errorf("contains %s twice", c)
+		}
+		seen[c] = true
+	}
+	return nil
+}
+
+// tokenOf returns a pointer to the token of p, or nil if p is nil.
+func tokenOf(p *Player) *Token {
+	if p == nil {
+		return nil
+	}
+	return &p.token
+}
@@ -0,0 +1,135 @@
+package monopoly
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGame_Save(t *testing.T) {
+	rules := DefaultRules()
+	rules.Random = NewSeededSource(7)
+	g := NewGame(rules, CAR, DOG, HAT)
+	p, _ := g.GetCurrentPlayer()
+	other := g.players[(g.currentTurn+1)%3]
+	p.inventory[BOARDWALK] = STATE_HOUSE_2
+	other.inventory[PARK_PLACE] = STATE_MORTGAGE
+	g.jailCards[CHANCE_GET_OUT_OF_JAIL_FREE] = other
+	p.pay(2000, other)
+	g.checkDebts()
+	if _, _, _, err := p.RollDice(); err == nil {
+		t.Fatal("Player.RollDice() while in debt got no error")
+	}
+
+	var buf bytes.Buffer
+	if err := g.Save(&buf); err != nil {
+		t.Fatalf("Game.Save() got error: %v", err)
+	}
+	loaded, err := LoadGame(&buf)
+	if err != nil {
+		t.Fatalf("LoadGame() got error: %v", err)
+	}
+
+	if loaded.GoString() != g.GoString() || loaded.state != g.state || loaded.currentTurn != g.currentTurn {
+		t.Fatalf("loaded game differs:\ngot  %#v\nwant %#v", loaded, g)
+	}
+	if !reflect.DeepEqual(loaded.chance, g.chance) || !reflect.DeepEqual(loaded.communityChest, g.communityChest) {
+		t.Error("loaded game has different decks")
+	}
+	lp := loaded.players[g.currentTurn]
+	if lp.game != loaded || lp.Debt() != p.Debt() || lp.debts[0].creditor != loaded.players[(g.currentTurn+1)%3] {
+		t.Errorf("loaded player has debts %#v, want %#v", lp.debts, p.debts)
+	}
+	if holder, _ := loaded.GetPlayerForCard(CHANCE_GET_OUT_OF_JAIL_FREE); holder.token != other.token {
+		t.Errorf("loaded Get Out of Jail Free card is held by %#v, want %#v", holder, other)
+	}
+	if seed, _ := loaded.Seed(); seed != 7 {
+		t.Errorf("loaded game has seed %d, want 7", seed)
+	}
+	for i := 0; i < 10; i++ {
+		g.rollDice()
+		loaded.rollDice()
+		if g.lastRoll != loaded.lastRoll {
+			t.Fatalf("roll %d after loading differs", i)
+		}
+	}
+}
+
+func TestLoadGame_version(t *testing.T) {
+	_, err := LoadGame(strings.NewReader(`{"version": 0}`))
+	if !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("LoadGame() of unknown version got error %v, want %v", err, ErrUnsupportedVersion)
+	}
+}
+
+func TestLoadGame_invalid(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG)
+	g.players[0].inventory[BOARDWALK] = STATE_HOUSE_1
+	data, err := json.Marshal(g)
+	if err != nil {
+		t.Fatalf("json.Marshal() got error: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(s map[string]any)
+	}{
+		{"unknown state", func(s map[string]any) { s["state"] = 99 }},
+		{"unknown resume state", func(s map[string]any) { s["resume_state"] = 99 }},
+		{"auction state without auction", func(s map[string]any) { s["state"] = GAME_AUCTION }},
+		{"empty deck", func(s map[string]any) { s["chance"] = []Card{} }},
+		{"card of other deck", func(s map[string]any) { s["chance"] = []Card{COMMUNITY_CHEST_BANK_ERROR} }},
+		{"duplicate card", func(s map[string]any) {
+			s["community_chest"] = []Card{COMMUNITY_CHEST_BANK_ERROR, COMMUNITY_CHEST_BANK_ERROR}
+		}},
+		{"unknown card", func(s map[string]any) { s["chance"] = []Card{99} }},
+		{"jail card", func(s map[string]any) { s["jail_cards"] = map[Card]Token{CHANCE_ADVANCE_TO_GO: CAR} }},
+		{"position off the board", func(s map[string]any) { decodedPlayer(s, 1)["position"] = 99 }},
+		{"negative position", func(s map[string]any) { decodedPlayer(s, 1)["position"] = -1 }},
+		{"no property", func(s map[string]any) { decodedPlayer(s, 1)["inventory"] = Inventory{Property(GO): STATE_NORMAL} }},
+		{"owned twice", func(s map[string]any) { decodedPlayer(s, 1)["inventory"] = Inventory{BOARDWALK: STATE_NORMAL} }},
+		{"unknown property state", func(s map[string]any) { decodedPlayer(s, 0)["inventory"] = Inventory{BOARDWALK: 6} }},
+		{"house on railroad", func(s map[string]any) {
+			decodedPlayer(s, 0)["inventory"] = Inventory{Property(READING_RAILROAD): STATE_HOUSE_1}
+		}},
+		{"card state without drawn card", func(s map[string]any) { s["state"] = GAME_CARD }},
+		{"bankrupt current player", func(s map[string]any) { decodedPlayer(s, int(s["current_turn"].(float64)))["bankrupt"] = true }},
+		{"auction of unknown building", func(s map[string]any) {
+			s["state"] = GAME_AUCTION
+			s["auction"] = map[string]any{"property": -1, "building": 5, "resume": GAME_TURN, "min_increment": 10}
+		}},
+		{"auction of property and building", func(s map[string]any) {
+			s["state"] = GAME_AUCTION
+			s["auction"] = map[string]any{"property": BOARDWALK, "building": HOUSE, "resume": GAME_TURN, "min_increment": 10}
+		}},
+		{"auction of nothing", func(s map[string]any) {
+			s["state"] = GAME_AUCTION
+			s["auction"] = map[string]any{"property": -1, "building": -1, "resume": GAME_TURN, "min_increment": 10}
+		}},
+		{"unknown player", func(s map[string]any) { s["jail_cards"] = map[Card]Token{CHANCE_GET_OUT_OF_JAIL_FREE: HAT} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var s map[string]any
+			if err := json.Unmarshal(data, &s); err != nil {
+				t.Fatalf("json.Unmarshal() got error: %v", err)
+			}
+			tt.modify(s)
+			modified, err := json.Marshal(s)
+			if err != nil {
+				t.Fatalf("json.Marshal() got error: %v", err)
+			}
+			if _, err := LoadGame(bytes.NewReader(modified)); !errors.Is(err, ErrInvalidSave) {
+				t.Errorf("LoadGame() got error %v, want %v", err, ErrInvalidSave)
+			}
+		})
+	}
+}
+
+// decodedPlayer returns the i-th player of the decoded save s.
+func decodedPlayer(s map[string]any, i int) map[string]any {
+	return s["players"].([]any)[i].(map[string]any)
+}
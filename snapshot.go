@@ -0,0 +1,226 @@
+package monopoly
+
+import (
+	"encoding/binary"
+	"fmt"
+	"slices"
+	"time"
+)
+
+const (
+	// snapshotMagic is the first byte of every binary snapshot.
+	snapshotMagic = 'M'
+	// snapshotVersion is the version of the binary snapshot format. It has to be increased with
+	// every incompatible change of the format.
+	snapshotVersion = 1
+)
+
+// snapshotStates are the states a snapshot can be taken in. All other states depend on values that
+// are not part of a snapshot, like a running auction.
+var snapshotStates = []GameState{GAME_TURN_START, GAME_ROLLED_DICE, GAME_MOVED_TO_NEW_FIELD, GAME_BUY_DECISION, GAME_TURN, GAME_IN_JAIL, GAME_OVER}
+
+// MarshalBinary implements [encoding.BinaryMarshaler] interface. It encodes a compact snapshot of
+// the position of g: the players with their position, money and jail counters, the owner and
+// [PropertyState] of every property, the holders of the Get Out of Jail Free cards and the bank.
+//
+// Rules, board and the order of the decks are not part of the snapshot, use [Game.Save] to store
+// them as well. Snapshots can't be taken during debts, auctions, card resolutions or trades.
+//
+// The format starts with the magic byte 'M' and the version, followed by:
+//
+//	players, current turn, state<<4|doubles, last roll
+//	per player: token, position, rounds in jail<<2|passed GO<<1|bankrupt,
+//	            reserved hotels<<4|reserved houses, money (varint)
+//	per property: owner (0 for the bank, otherwise player index+1)<<3 | PropertyState+1
+//	holders of both Get Out of Jail Free cards (like owners), houses, hotels, jackpot (varint)
+func (g *Game) MarshalBinary() ([]byte, error) {
+	g.lock()
+	defer g.unlock()
+	if err := g.checkState(snapshotStates...); err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, 0, 64)
+	data = append(data, snapshotMagic, snapshotVersion)
+	data = append(data, byte(len(g.players)), byte(g.currentTurn), byte(g.state)<<4|byte(g.doubblesCount), g.lastRoll)
+
+	index := make(map[*Player]byte, len(g.players))
+	for i, p := range g.players {
+		index[p] = byte(i + 1)
+		var flags byte
+		if p.bankrupt {
+			flags |= 1
+		}
+		if p.passedGo {
+			flags |= 1 << 1
+		}
+		flags |= byte(p.roundsInJail) << 2
+		data = append(data, byte(p.token), byte(p.position), flags, byte(p.reserved[HOTEL])<<4|byte(p.reserved[HOUSE]))
+		data = binary.AppendVarint(data, int64(p.money))
+	}
+
+	for f := GO; int(f) < numberOfFields; f++ {
+		prop, ok := g.board.Property(f)
+		if !ok {
+			continue
+		}
+		var b byte
+		if owner, state, ok := g.playerForProperty(prop); ok {
+			b = index[owner]<<3 | byte(state+1)
+		}
+		data = append(data, b)
+	}
+
+	for _, c := range []Card{CHANCE_GET_OUT_OF_JAIL_FREE, COMMUNITY_CHEST_GET_OUT_OF_JAIL_FREE} {
+		data = append(data, index[g.jailCards[c]])
+	}
+	data = append(data, byte(g.houses), byte(g.hotels))
+	data = binary.AppendVarint(data, int64(g.jackpot))
+	return data, nil
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler] interface. It restores a snapshot created
+// by [Game.MarshalBinary]. The rules, board, random source, language and the order of the decks of g
+// are kept. When g is a zero Game, the [DefaultRules] are used and the decks are shuffled anew.
+func (g *Game) UnmarshalBinary(data []byte) error {
+	if len(data) < 2 || data[0] != snapshotMagic {
+		return ErrInvalidSnapshot
+	}
+	if data[1] != snapshotVersion {
+		return fmt.Errorf("%w: got %d, want %d", ErrUnsupportedVersion, data[1], snapshotVersion)
+	}
+	r := snapshotReader{data: data[2:]}
+
+	g.lock()
+	defer g.unlock()
+
+	numPlayers, currentTurn := int(r.byte()), int(r.byte())
+	stateDoubles, lastRoll := r.byte(), r.byte()
+	state := GameState(stateDoubles >> 4)
+	if r.err != nil || numPlayers < 2 || currentTurn >= numPlayers || !slices.Contains(snapshotStates, state) || lastRoll>>4 > 6 || lastRoll&0xf > 6 {
+		return ErrInvalidSnapshot
+	}
+
+	loaded := &Game{
+		Language:       g.Language,
+		rules:          g.rules,
+		board:          g.board,
+		random:         g.random,
+		players:        make([]*Player, numPlayers),
+		currentTurn:    currentTurn,
+		lastRoll:       lastRoll,
+		doubblesCount:  int(stateDoubles & 0xf),
+		state:          state,
+		chance:         slices.Clone(g.chance),
+		communityChest: slices.Clone(g.communityChest),
+		jailCards:      map[Card]*Player{},
+	}
+	if g.board == nil {
+		loaded.rules = DefaultRules()
+		loaded.rules.Random = NewSeededSource(time.Now().UnixNano())
+		loaded.board = loaded.rules.Board
+		loaded.random = loaded.rules.Random
+		loaded.chance = newDeck(allChanceCards(), loaded.random)
+		loaded.communityChest = newDeck(allCommunityChestCards(), loaded.random)
+	}
+
+	seen := map[Token]bool{}
+	for i := range loaded.players {
+		token, position, flags, reserved := Token(r.byte()), r.byte(), r.byte(), r.byte()
+		if seen[token] || !slices.Contains(AllTokens(), token) || position > byte(IN_JAIL) {
+			return ErrInvalidSnapshot
+		}
+		seen[token] = true
+		p := InitPlayer(loaded, token)
+		p.position = Field(position)
+		p.bankrupt = flags&1 != 0
+		p.passedGo = flags&(1<<1) != 0
+		p.roundsInJail = int(flags >> 2)
+		p.reserved = [2]int{int(reserved & 0xf), int(reserved >> 4)}
+		p.money = int(r.varint())
+		loaded.players[i] = p
+	}
+
+	player := func(b byte) *Player {
+		if b == 0 || int(b) > numPlayers {
+			r.err = ErrInvalidSnapshot
+			return nil
+		}
+		return loaded.players[b-1]
+	}
+	for f := GO; int(f) < numberOfFields; f++ {
+		prop, ok := loaded.board.Property(f)
+		if !ok {
+			continue
+		}
+		b := r.byte()
+		if b == 0 {
+			continue
+		}
+		state := PropertyState(b&0x7) - 1
+		owner := player(b >> 3)
+		if owner == nil || state > STATE_HOTEL || state > STATE_NORMAL && loaded.board.Kind(f) != KIND_STREET {
+			return ErrInvalidSnapshot
+		}
+		owner.inventory[prop] = state
+	}
+
+	for _, c := range []Card{CHANCE_GET_OUT_OF_JAIL_FREE, COMMUNITY_CHEST_GET_OUT_OF_JAIL_FREE} {
+		deck := loaded.deck(c)
+		b := r.byte()
+		if b == 0 {
+			if !slices.Contains(*deck, c) {
+				deck.putBack(c)
+			}
+			continue
+		}
+		holder := player(b)
+		if holder == nil {
+			return ErrInvalidSnapshot
+		}
+		loaded.jailCards[c] = holder
+		*deck = slices.DeleteFunc(*deck, func(dc Card) bool { return dc == c })
+	}
+	loaded.houses, loaded.hotels = int(r.byte()), int(r.byte())
+	loaded.jackpot = int(r.varint())
+	if r.err != nil || len(r.data) != 0 || loaded.jackpot < 0 {
+		return ErrInvalidSnapshot
+	}
+
+	g.copyState(loaded)
+	g.log, g.undo, g.redo = nil, nil, nil
+	for _, p := range g.players {
+		p.game = g
+	}
+	return nil
+}
+
+// snapshotReader reads the values of a binary snapshot. After the first error all reads return 0
+// and err is set.
+type snapshotReader struct {
+	data []byte
+	err  error
+}
+
+func (r *snapshotReader) byte() byte {
+	if r.err != nil || len(r.data) == 0 {
+		r.err = ErrInvalidSnapshot
+		return 0
+	}
+	b := r.data[0]
+	r.data = r.data[1:]
+	return b
+}
+
+func (r *snapshotReader) varint() int64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(r.data)
+	if n <= 0 {
+		r.err = ErrInvalidSnapshot
+		return 0
+	}
+	r.data = r.data[n:]
+	return v
+}
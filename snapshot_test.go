@@ -0,0 +1,131 @@
+package monopoly
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestGame_MarshalBinary(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG, HAT, SHIP)
+	p, _ := g.GetCurrentPlayer()
+	other := g.players[(g.currentTurn+1)%4]
+	p.inventory[BOARDWALK] = STATE_HOTEL
+	p.inventory[PARK_PLACE] = STATE_HOTEL
+	other.inventory[Property(READING_RAILROAD)] = STATE_MORTGAGE
+	other.goToJail()
+	other.roundsInJail = 2
+	other.money = 12345
+	g.hotels -= 2
+	g.jailCards[COMMUNITY_CHEST_GET_OUT_OF_JAIL_FREE] = p
+	g.setLastRoll(6, 6)
+	g.state = GAME_TURN
+
+	data, err := g.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Game.MarshalBinary() got error: %v", err)
+	}
+	if len(data) > 64 {
+		t.Errorf("Game.MarshalBinary() got %d bytes, want at most 64", len(data))
+	}
+
+	var loaded Game
+	if err := loaded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("Game.UnmarshalBinary() got error: %v", err)
+	}
+	if loaded.currentTurn != g.currentTurn || loaded.state != g.state || loaded.lastRoll != g.lastRoll {
+		t.Fatalf("Game.UnmarshalBinary() got turn %d in %#v, want turn %d in %#v", loaded.currentTurn, loaded.state, g.currentTurn, g.state)
+	}
+	for i, want := range g.players {
+		got := loaded.players[i]
+		if got.token != want.token || got.position != want.position || got.money != want.money || !reflect.DeepEqual(got.inventory, want.inventory) {
+			t.Errorf("restored player %d got %#v, want %#v", i, got, want)
+		}
+	}
+	if lo := loaded.players[(g.currentTurn+1)%4]; lo.roundsInJail != 2 || lo.game != &loaded {
+		t.Errorf("restored player in jail got %d rounds", lo.roundsInJail)
+	}
+	if len(loaded.communityChest) != len(allCommunityChestCards())-1 || loaded.hotels != g.hotels {
+		t.Errorf("restored game has %d community chest cards and %d hotels", len(loaded.communityChest), loaded.hotels)
+	}
+	if again, _ := loaded.MarshalBinary(); !bytes.Equal(again, data) {
+		t.Errorf("snapshot of restored game got %v, want %v", again, data)
+	}
+
+	data[1] = snapshotVersion + 1
+	if err := loaded.UnmarshalBinary(data); !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("Game.UnmarshalBinary() of unknown version got error %v, want %v", err, ErrUnsupportedVersion)
+	}
+	data[1] = snapshotVersion
+	if err := loaded.UnmarshalBinary(data[:len(data)-3]); err != ErrInvalidSnapshot {
+		t.Errorf("Game.UnmarshalBinary() of truncated snapshot got error %v, want %v", err, ErrInvalidSnapshot)
+	}
+}
+
+func TestGame_UnmarshalBinary_invalid(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG)
+	g.players[0].inventory[Property(READING_RAILROAD)] = STATE_NORMAL
+	data, err := g.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Game.MarshalBinary() got error: %v", err)
+	}
+	if err := new(Game).UnmarshalBinary(data); err != nil {
+		t.Fatalf("Game.UnmarshalBinary() of a valid snapshot got error: %v", err)
+	}
+	g.players[0].inventory[Property(READING_RAILROAD)] = STATE_HOUSE_1
+	withHouse, _ := g.MarshalBinary()
+
+	tests := []struct {
+		name   string
+		data   []byte
+		modify func(data []byte)
+	}{
+		{"negative position", data, func(data []byte) { data[7] = 200 }},
+		{"position off the board", data, func(data []byte) { data[7] = byte(IN_JAIL) + 1 }},
+		{"unknown token", data, func(data []byte) { data[6] = 200 }},
+		{"auction state", data, func(data []byte) { data[4] = byte(GAME_AUCTION) << 4 }},
+		{"unknown state", data, func(data []byte) { data[4] = 0xf0 }},
+		{"invalid dice", data, func(data []byte) { data[5] = 7<<4 | 1 }},
+		{"house on railroad", withHouse, func(data []byte) {}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			corrupt := bytes.Clone(tt.data)
+			tt.modify(corrupt)
+			var loaded Game
+			if err := loaded.UnmarshalBinary(corrupt); err != ErrInvalidSnapshot {
+				t.Errorf("Game.UnmarshalBinary() got error %v, want %v", err, ErrInvalidSnapshot)
+			}
+		})
+	}
+}
+
+func TestGame_UnmarshalBinary_keepsRandom(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG)
+	g.jailCards[CHANCE_GET_OUT_OF_JAIL_FREE] = g.players[0]
+	data, err := g.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Game.MarshalBinary() got error: %v", err)
+	}
+
+	rules := DefaultRules()
+	src := NewSeededSource(1)
+	rules.Random = src
+	other := NewGame(rules, HAT, SHIP, CAR)
+	draws := src.src.n
+	communityChest := slices.Clone(other.communityChest)
+	if err := other.UnmarshalBinary(data); err != nil {
+		t.Fatalf("Game.UnmarshalBinary() got error: %v", err)
+	}
+	if src.src.n != draws {
+		t.Errorf("Game.UnmarshalBinary() drew %d random values, want none", src.src.n-draws)
+	}
+	if !slices.Equal(other.communityChest, communityChest) {
+		t.Errorf("Game.UnmarshalBinary() changed the community chest deck to %v, want %v", other.communityChest, communityChest)
+	}
+	if len(other.chance) != len(allChanceCards())-1 || other.jailCards[CHANCE_GET_OUT_OF_JAIL_FREE] != other.players[0] {
+		t.Errorf("Game.UnmarshalBinary() got %d chance cards with the Get Out of Jail Free card held by %v", len(other.chance), other.jailCards[CHANCE_GET_OUT_OF_JAIL_FREE])
+	}
+}
@@ -1,6 +1,9 @@
 package monopoly
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/Kesuaheli/monopoly/lang"
 	"golang.org/x/text/language"
 )
@@ -108,6 +111,27 @@ func (t Token) GoString() string {
 	}
 }
 
+// MarshalText implements [encoding.TextMarshaler] interface. The text is the lower case name of t,
+// e.g. "money_bag".
+func (t Token) MarshalText() ([]byte, error) {
+	name := t.GoString()
+	if name == "UNKNOWN" {
+		return nil, fmt.Errorf("unknown token %d", t)
+	}
+	return []byte(strings.ToLower(name)), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler] interface.
+func (t *Token) UnmarshalText(text []byte) error {
+	for _, token := range AllTokens() {
+		if name, _ := token.MarshalText(); string(name) == string(text) {
+			*t = token
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown token %q", text)
+}
+
 func (t Token) Description(langTag language.Tag) string {
 	switch t {
 	case BOOT:
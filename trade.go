@@ -0,0 +1,233 @@
+package monopoly
+
+// Trade is an offer to exchange properties, Get Out of Jail Free cards and money between the
+// player proposing it and another player.
+type Trade struct {
+	Offer        []Property `json:"offer,omitempty"`         // properties the proposing player gives
+	Request      []Property `json:"request,omitempty"`       // properties the other player gives
+	OfferCards   []Card     `json:"offer_cards,omitempty"`   // cards the proposing player gives
+	RequestCards []Card     `json:"request_cards,omitempty"` // cards the other player gives
+	Money        int        `json:"money,omitempty"`         // money the proposing player pays, negative if they receive it
+}
+
+// clone returns a deep copy of t.
+func (t Trade) clone() *Trade {
+	return &Trade{
+		Offer:        append([]Property(nil), t.Offer...),
+		Request:      append([]Property(nil), t.Request...),
+		OfferCards:   append([]Card(nil), t.OfferCards...),
+		RequestCards: append([]Card(nil), t.RequestCards...),
+		Money:        t.Money,
+	}
+}
+
+// hasDuplicates reports whether t lists any property or card more than once.
+func (t Trade) hasDuplicates() bool {
+	props := make(map[Property]bool, len(t.Offer)+len(t.Request))
+	for _, prop := range append(append([]Property(nil), t.Offer...), t.Request...) {
+		if props[prop] {
+			return true
+		}
+		props[prop] = true
+	}
+	cards := make(map[Card]bool, len(t.OfferCards)+len(t.RequestCards))
+	for _, c := range append(append([]Card(nil), t.OfferCards...), t.RequestCards...) {
+		if cards[c] {
+			return true
+		}
+		cards[c] = true
+	}
+	return false
+}
+
+// empty reports whether t exchanges nothing at all.
+func (t Trade) empty() bool {
+	return len(t.Offer) == 0 && len(t.Request) == 0 && len(t.OfferCards) == 0 && len(t.RequestCards) == 0 && t.Money == 0
+}
+
+// TradeProposal is a [Trade] From proposed To another player, who still has to accept or reject
+// it.
+type TradeProposal struct {
+	From, To *Player
+	Trade
+}
+
+// PendingTrade returns the trade proposal waiting for an answer and reports whether there is one.
+func (g *Game) PendingTrade() (TradeProposal, bool) {
+	g.lock()
+	defer g.unlock()
+	if g.trade == nil {
+		return TradeProposal{}, false
+	}
+	return TradeProposal{From: g.trade.From, To: g.trade.To, Trade: *g.trade.Trade.clone()}, true
+}
+
+// ProposeTrade makes p propose the trade t to the player to. The game waits until to accepts or
+// rejects it.
+func (p *Player) ProposeTrade(to *Player, t Trade) error {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.proposeTrade(to, t)
+}
+
+// proposeTrade is like ProposeTrade, but expects the game to be locked.
+func (p *Player) proposeTrade(to *Player, t Trade) error {
+	if err := p.canProposeTrade(to, t); err != nil {
+		return err
+	}
+	p.game.record(Action{Kind: ACTION_PROPOSE_TRADE, Player: p.token, Target: to.token, Trade: t.clone()})
+
+	p.game.trade = &TradeProposal{From: p, To: to, Trade: *t.clone()}
+	p.game.state = GAME_TRADE
+	p.game.emit(TradeProposedEvent{From: p, To: to})
+	return nil
+}
+
+// canProposeTrade returns an error why p can't propose t to the player to.
+func (p *Player) canProposeTrade(to *Player, t Trade) error {
+	if err := p.checkTurn(GAME_TURN); err != nil {
+		return err
+	}
+	if to == p || to.bankrupt {
+		return ErrNotTradePartner
+	}
+	if t.empty() {
+		return ErrEmptyTrade
+	}
+	return checkTrade(p, to, t)
+}
+
+// canProposeAnyTrade returns an error why p can't propose a trade to any other player right now.
+func (p *Player) canProposeAnyTrade() error {
+	if err := p.checkTurn(GAME_TURN); err != nil {
+		return err
+	}
+	for _, other := range p.game.players {
+		if other != p && !other.bankrupt {
+			return nil
+		}
+	}
+	return ErrNotTradePartner
+}
+
+// checkTrade returns an error if t lists anything twice, from and to don't own what they would
+// give in t, or a street they would give is in a color group with buildings.
+func checkTrade(from, to *Player, t Trade) error {
+	if t.hasDuplicates() {
+		return ErrDuplicateInTrade
+	}
+	for _, prop := range t.Offer {
+		if _, hasProp := from.inventory[prop]; !hasProp {
+			return ErrNotOwner
+		}
+		if err := from.checkGroupBuildings(prop); err != nil {
+			return err
+		}
+	}
+	for _, prop := range t.Request {
+		if _, hasProp := to.inventory[prop]; !hasProp {
+			return ErrNotOwner
+		}
+		if err := to.checkGroupBuildings(prop); err != nil {
+			return err
+		}
+	}
+	for _, c := range t.OfferCards {
+		if holder, ok := from.game.jailCards[c]; !ok || holder != from {
+			return ErrNotCardHolder
+		}
+	}
+	for _, c := range t.RequestCards {
+		if holder, ok := to.game.jailCards[c]; !ok || holder != to {
+			return ErrNotCardHolder
+		}
+	}
+	if t.Money < 0 {
+		return to.checkFunds(-t.Money)
+	}
+	return from.checkFunds(t.Money)
+}
+
+// AcceptTrade makes p accept the pending trade proposed to them. Everything is exchanged at once.
+func (p *Player) AcceptTrade() error {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.acceptTrade()
+}
+
+// acceptTrade is like AcceptTrade, but expects the game to be locked.
+func (p *Player) acceptTrade() error {
+	if err := p.canAcceptTrade(); err != nil {
+		return err
+	}
+	p.game.record(Action{Kind: ACTION_ACCEPT_TRADE, Player: p.token})
+
+	tp := p.game.trade
+	from, to := tp.From, tp.To
+	for _, prop := range tp.Offer {
+		to.inventory[prop] = from.inventory[prop]
+		delete(from.inventory, prop)
+	}
+	for _, prop := range tp.Request {
+		from.inventory[prop] = to.inventory[prop]
+		delete(to.inventory, prop)
+	}
+	for _, c := range tp.OfferCards {
+		p.game.jailCards[c] = to
+	}
+	for _, c := range tp.RequestCards {
+		p.game.jailCards[c] = from
+	}
+	from.money -= tp.Money
+	to.money += tp.Money
+
+	p.game.trade = nil
+	p.game.state = GAME_TURN
+	p.game.emit(TradeResolvedEvent{From: from, To: to, Accepted: true})
+	return nil
+}
+
+// canAcceptTrade returns an error why p can't accept the pending trade.
+func (p *Player) canAcceptTrade() error {
+	if err := p.game.checkState(GAME_TRADE); err != nil {
+		return err
+	}
+	tp := p.game.trade
+	if tp.To != p {
+		return ErrNotTradePartner
+	}
+	return checkTrade(tp.From, tp.To, tp.Trade)
+}
+
+// RejectTrade makes p reject the pending trade proposed to them. The proposing player may reject
+// it as well to withdraw the proposal.
+func (p *Player) RejectTrade() error {
+	p.game.lock()
+	defer p.game.unlock()
+	return p.rejectTrade()
+}
+
+// rejectTrade is like RejectTrade, but expects the game to be locked.
+func (p *Player) rejectTrade() error {
+	if err := p.canRejectTrade(); err != nil {
+		return err
+	}
+	p.game.record(Action{Kind: ACTION_REJECT_TRADE, Player: p.token})
+
+	tp := p.game.trade
+	p.game.trade = nil
+	p.game.state = GAME_TURN
+	p.game.emit(TradeResolvedEvent{From: tp.From, To: tp.To, Accepted: false})
+	return nil
+}
+
+// canRejectTrade returns an error why p can't reject the pending trade.
+func (p *Player) canRejectTrade() error {
+	if err := p.game.checkState(GAME_TRADE); err != nil {
+		return err
+	}
+	if tp := p.game.trade; tp.To != p && tp.From != p {
+		return ErrNotTradePartner
+	}
+	return nil
+}
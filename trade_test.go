@@ -0,0 +1,152 @@
+package monopoly
+
+import "testing"
+
+func TestPlayer_ProposeTrade(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG, HAT)
+	p, _ := g.GetCurrentPlayer()
+	other := g.players[(g.currentTurn+1)%3]
+	third := g.players[(g.currentTurn+2)%3]
+	p.inventory[BOARDWALK] = STATE_MORTGAGE
+	other.inventory[PARK_PLACE] = STATE_NORMAL
+	g.jailCards[CHANCE_GET_OUT_OF_JAIL_FREE] = other
+	g.state = GAME_TURN
+
+	if err := p.ProposeTrade(other, Trade{}); err != ErrEmptyTrade {
+		t.Errorf("Player.ProposeTrade() with an empty trade got error %v, want %v", err, ErrEmptyTrade)
+	}
+	if err := p.ProposeTrade(p, Trade{Money: 10}); err != ErrNotTradePartner {
+		t.Errorf("Player.ProposeTrade() to themselves got error %v, want %v", err, ErrNotTradePartner)
+	}
+	if err := p.ProposeTrade(other, Trade{Request: []Property{BOARDWALK}}); err != ErrNotOwner {
+		t.Errorf("Player.ProposeTrade() requesting a foreign property got error %v, want %v", err, ErrNotOwner)
+	}
+	if err := other.ProposeTrade(p, Trade{Money: 10}); err != ErrNotYourTurn {
+		t.Errorf("Player.ProposeTrade() out of turn got error %v, want %v", err, ErrNotYourTurn)
+	}
+
+	trade := Trade{
+		Offer:        []Property{BOARDWALK},
+		Request:      []Property{PARK_PLACE},
+		RequestCards: []Card{CHANCE_GET_OUT_OF_JAIL_FREE},
+		Money:        -50,
+	}
+	if err := p.ProposeTrade(other, trade); err != nil {
+		t.Fatalf("Player.ProposeTrade() got error: %v", err)
+	}
+	if g.state != GAME_TRADE {
+		t.Fatalf("Game state got %#v, want %#v", g.state, GAME_TRADE)
+	}
+	if tp, ok := g.PendingTrade(); !ok || tp.From != p || tp.To != other || tp.Money != -50 {
+		t.Errorf("Game.PendingTrade() got %v, %t", tp, ok)
+	}
+	if err := third.AcceptTrade(); err != ErrNotTradePartner {
+		t.Errorf("Player.AcceptTrade() by a third player got error %v, want %v", err, ErrNotTradePartner)
+	}
+	if err := p.AcceptTrade(); err != ErrNotTradePartner {
+		t.Errorf("Player.AcceptTrade() by the proposing player got error %v, want %v", err, ErrNotTradePartner)
+	}
+
+	if err := other.AcceptTrade(); err != nil {
+		t.Fatalf("Player.AcceptTrade() got error: %v", err)
+	}
+	if g.state != GAME_TURN {
+		t.Errorf("Game state got %#v, want %#v", g.state, GAME_TURN)
+	}
+	if state, ok := other.inventory[BOARDWALK]; !ok || state != STATE_MORTGAGE {
+		t.Errorf("%#v after the trade got %#v, %t, want mortgaged", BOARDWALK, state, ok)
+	}
+	if _, ok := p.inventory[PARK_PLACE]; !ok {
+		t.Errorf("%#v was not traded", PARK_PLACE)
+	}
+	if g.jailCards[CHANCE_GET_OUT_OF_JAIL_FREE] != p {
+		t.Error("Get Out of Jail Free card was not traded")
+	}
+	if p.money != defaultRules.StartMoney+50 || other.money != defaultRules.StartMoney-50 {
+		t.Errorf("money after the trade got %d and %d", p.money, other.money)
+	}
+	if _, ok := g.PendingTrade(); ok {
+		t.Error("Game.PendingTrade() after accepting got a trade")
+	}
+}
+
+func TestPlayer_RejectTrade(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG)
+	p, _ := g.GetCurrentPlayer()
+	other := g.players[1-g.currentTurn]
+	p.inventory[BOARDWALK] = STATE_NORMAL
+	g.state = GAME_TURN
+
+	for _, rejecting := range []*Player{other, p} {
+		if err := p.ProposeTrade(other, Trade{Offer: []Property{BOARDWALK}, Money: 100}); err != nil {
+			t.Fatalf("Player.ProposeTrade() got error: %v", err)
+		}
+		if err := rejecting.RejectTrade(); err != nil {
+			t.Fatalf("Player.RejectTrade() by %#v got error: %v", rejecting.token, err)
+		}
+		if _, ok := p.inventory[BOARDWALK]; !ok || p.money != defaultRules.StartMoney || g.state != GAME_TURN {
+			t.Errorf("after rejecting by %#v: money = %d, state = %#v", rejecting.token, p.money, g.state)
+		}
+	}
+}
+
+func TestPlayer_ProposeTrade_buildings(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG)
+	p, _ := g.GetCurrentPlayer()
+	other := g.players[1-g.currentTurn]
+	p.inventory[PARK_PLACE] = STATE_NORMAL
+	other.inventory[MEDITERRANEAN_AVENUE] = STATE_HOUSE_1
+	other.inventory[BALTIC_AVENUE] = STATE_NORMAL
+	g.state = GAME_TURN
+
+	if err := p.ProposeTrade(other, Trade{Request: []Property{BALTIC_AVENUE}}); err != ErrBuildingsInGroup {
+		t.Errorf("Player.ProposeTrade() requesting a street in a group with houses got error %v, want %v", err, ErrBuildingsInGroup)
+	}
+
+	p.inventory[BOARDWALK] = STATE_NORMAL
+	if err := p.ProposeTrade(other, Trade{Offer: []Property{PARK_PLACE}, Money: -100}); err != nil {
+		t.Fatalf("Player.ProposeTrade() got error: %v", err)
+	}
+	p.inventory[BOARDWALK] = STATE_HOUSE_1
+	p.inventory[PARK_PLACE] = STATE_HOUSE_1
+	if err := other.AcceptTrade(); err != ErrBuildingsInGroup {
+		t.Errorf("Player.AcceptTrade() after building on the offered group got error %v, want %v", err, ErrBuildingsInGroup)
+	}
+}
+
+func TestPlayer_ProposeTrade_duplicates(t *testing.T) {
+	g := NewGame(DefaultRules(), CAR, DOG)
+	p, _ := g.GetCurrentPlayer()
+	other := g.players[1-g.currentTurn]
+	p.inventory[BOARDWALK] = STATE_MORTGAGE
+	other.inventory[PARK_PLACE] = STATE_NORMAL
+	g.jailCards[CHANCE_GET_OUT_OF_JAIL_FREE] = p
+	g.jailCards[COMMUNITY_CHEST_GET_OUT_OF_JAIL_FREE] = other
+	g.state = GAME_TURN
+
+	tests := []struct {
+		name  string
+		trade Trade
+	}{
+		{"offer", Trade{Offer: []Property{BOARDWALK, BOARDWALK}}},
+		{"request", Trade{Request: []Property{PARK_PLACE, PARK_PLACE}}},
+		{"offered cards", Trade{OfferCards: []Card{CHANCE_GET_OUT_OF_JAIL_FREE, CHANCE_GET_OUT_OF_JAIL_FREE}}},
+		{"requested cards", Trade{RequestCards: []Card{COMMUNITY_CHEST_GET_OUT_OF_JAIL_FREE, COMMUNITY_CHEST_GET_OUT_OF_JAIL_FREE}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := p.ProposeTrade(other, tt.trade); err != ErrDuplicateInTrade {
+				t.Errorf("Player.ProposeTrade() got error %v, want %v", err, ErrDuplicateInTrade)
+			}
+		})
+	}
+
+	g.state = GAME_TRADE
+	g.trade = &TradeProposal{From: p, To: other, Trade: Trade{Offer: []Property{BOARDWALK, BOARDWALK}}}
+	if err := other.AcceptTrade(); err != ErrDuplicateInTrade {
+		t.Errorf("Player.AcceptTrade() of a trade with duplicates got error %v, want %v", err, ErrDuplicateInTrade)
+	}
+	if p.inventory[BOARDWALK] != STATE_MORTGAGE || len(other.inventory) != 1 {
+		t.Errorf("rejected trade moved Boardwalk to %#v", other.inventory)
+	}
+}
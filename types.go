@@ -57,124 +57,24 @@ const (
 	IN_JAIL Field = iota
 )
 
+// GetRentCost returns the rent for p in the state ps on the classic board. See [Board.Rent].
 func (p Property) GetRentCost(ps PropertyState) int {
-	if ps == STATE_MORTGAGE {
-		return 0
-	}
-
-	if _, ok := p.Railroad(); ok {
-		return 25
-	} else if _, ok := p.Utility(); ok {
-		return 0 // must be calculated seperately
-	}
-
-	switch p {
-	case MEDITERRANEAN_AVENUE:
-		return []int{2, 10, 30, 90, 160, 250}[ps]
-	case BALTIC_AVENUE:
-		return []int{4, 20, 60, 180, 320, 450}[ps]
-
-	case ORIENTAL_AVENUE, VERMONT_AVENUE:
-		return []int{6, 30, 90, 270, 400, 550}[ps]
-	case CONNECTICUT_AVENUE:
-		return []int{8, 40, 100, 300, 450, 600}[ps]
-
-	case ST_CHARLES_PLACE, STATES_AVENUE:
-		return []int{10, 50, 150, 450, 625, 750}[ps]
-	case VIRGINIA_AVENUE:
-		return []int{12, 60, 180, 500, 700, 900}[ps]
-
-	case ST_JAMES_PLACE, TENNESSEE_AVENUE:
-		return []int{14, 70, 200, 550, 750, 950}[ps]
-	case NEW_YORK_AVENUE:
-		return []int{16, 80, 220, 600, 800, 1000}[ps]
-
-	case KENTUCKY_AVENUE, INDIANA_AVENUE:
-		return []int{18, 90, 250, 700, 875, 1050}[ps]
-	case ILLINOIS_AVENUE:
-		return []int{20, 100, 300, 750, 925, 1100}[ps]
-
-	case ATLANTIC_AVENUE, VENTNOR_AVENUE:
-		return []int{22, 110, 330, 800, 975, 1150}[ps]
-	case MARVIN_GARDENS:
-		return []int{24, 120, 360, 850, 1025, 1200}[ps]
-
-	case PACIFIC_AVENUE, NORTH_CAROLINA_AVENUE:
-		return []int{26, 130, 390, 900, 1100, 1275}[ps]
-	case PENNSYLVANIA_AVENUE:
-		return []int{28, 150, 450, 1000, 1200, 1400}[ps]
-
-	case PARK_PLACE:
-		return []int{35, 175, 500, 1100, 1300, 1500}[ps]
-	case BOARDWALK:
-		return []int{50, 200, 600, 1400, 1700, 2000}[ps]
-
-	default:
-		return 0
-	}
+	return defaultBoard.Rent(p, ps)
 }
 
+// GetBaseCost returns the price of p on the classic board.
 func (p Property) GetBaseCost() int {
-	if _, ok := p.Railroad(); ok {
-		return 200
-	} else if _, ok := p.Utility(); ok {
-		return 150
-	}
-
-	switch p {
-	case MEDITERRANEAN_AVENUE, BALTIC_AVENUE:
-		return 60
-	case ORIENTAL_AVENUE, VERMONT_AVENUE:
-		return 100
-	case CONNECTICUT_AVENUE:
-		return 120
-	case ST_CHARLES_PLACE, STATES_AVENUE:
-		return 140
-	case VIRGINIA_AVENUE:
-		return 160
-	case ST_JAMES_PLACE, TENNESSEE_AVENUE:
-		return 180
-	case NEW_YORK_AVENUE:
-		return 200
-	case KENTUCKY_AVENUE, INDIANA_AVENUE:
-		return 220
-	case ILLINOIS_AVENUE:
-		return 240
-	case ATLANTIC_AVENUE, VENTNOR_AVENUE:
-		return 160
-	case MARVIN_GARDENS:
-		return 280
-	case PACIFIC_AVENUE, NORTH_CAROLINA_AVENUE:
-		return 300
-	case PENNSYLVANIA_AVENUE:
-		return 320
-	case PARK_PLACE:
-		return 350
-	case BOARDWALK:
-		return 400
-	default:
-		return 0
-	}
+	return defaultBoard.Price(p)
 }
 
+// GetHouseCost returns the price of a single house or hotel on p on the classic board.
 func (p Property) GetHouseCost() int {
-	const baseHouseCost = 50
-	switch p {
-	case MEDITERRANEAN_AVENUE, BALTIC_AVENUE, ORIENTAL_AVENUE, VERMONT_AVENUE, CONNECTICUT_AVENUE:
-		return baseHouseCost
-	case ST_CHARLES_PLACE, STATES_AVENUE, VIRGINIA_AVENUE, ST_JAMES_PLACE, TENNESSEE_AVENUE, NEW_YORK_AVENUE:
-		return baseHouseCost * 2
-	case KENTUCKY_AVENUE, INDIANA_AVENUE, ILLINOIS_AVENUE, ATLANTIC_AVENUE, VENTNOR_AVENUE, MARVIN_GARDENS:
-		return baseHouseCost * 3
-	case PACIFIC_AVENUE, NORTH_CAROLINA_AVENUE, PENNSYLVANIA_AVENUE, PARK_PLACE, BOARDWALK:
-		return baseHouseCost * 4
-	default:
-		return 0
-	}
+	return defaultBoard.HouseCost(p)
 }
 
+// GetMortgageValue returns the money the bank pays for mortgaging p on the classic board.
 func (p Property) GetMortgageValue() int {
-	return p.GetBaseCost() / 2
+	return defaultBoard.MortgageValue(p)
 }
 
 type PropertyState int8
@@ -0,0 +1,159 @@
+package monopoly
+
+// UndoPolicy configures which actions can be reverted with [Game.Undo]. Rolling the dice and moving
+// can never be undone, because that would allow to roll again.
+type UndoPolicy struct {
+	// Limit is the maximum number of actions that can be undone. 0 disables undo.
+	Limit int `json:"limit"`
+	// AcrossTurns allows to undo ending a turn, as long as the next player didn't roll the dice yet.
+	AcrossTurns bool `json:"across_turns"`
+}
+
+// undoState is the state of a game before an action was applied.
+type undoState struct {
+	action  Action
+	game    *Game
+	players []playerState
+	logLen  int
+}
+
+// playerState is the state of a single player within an [undoState].
+type playerState struct {
+	position     Field
+	money        int
+	inventory    Inventory
+	roundsInJail int
+	debts        []debt
+	bankrupt     bool
+	reserved     [2]int
+	passedGo     bool
+}
+
+// saveUndo is called by [Game.record] before a is applied. It saves the state of g for undoing a,
+// or clears the undo history if a can't be undone.
+func (g *Game) saveUndo(a Action) {
+	if !g.redoing {
+		g.redo = nil
+	}
+	policy := g.rules.Undo
+	if policy.Limit <= 0 {
+		return
+	}
+	switch a.Kind {
+	case ACTION_ROLL_DICE, ACTION_MOVE:
+		g.undo = nil
+		return
+	case ACTION_END_TURN:
+		if !policy.AcrossTurns {
+			g.undo = nil
+			return
+		}
+	}
+
+	s := undoState{
+		action:  a,
+		game:    &Game{},
+		players: make([]playerState, len(g.players)),
+	}
+	s.game.copyState(g)
+	s.game.chance = append(Deck(nil), g.chance...)
+	s.game.communityChest = append(Deck(nil), g.communityChest...)
+	s.game.drawnCards = append([]Card(nil), g.drawnCards...)
+	s.game.jailCards = make(map[Card]*Player, len(g.jailCards))
+	for c, p := range g.jailCards {
+		s.game.jailCards[c] = p
+	}
+	if g.auction != nil {
+		s.game.auction = g.auction.clone()
+	}
+	if g.log != nil {
+		s.logLen = len(g.log.Actions)
+	}
+
+	for i, p := range g.players {
+		inventory := make(Inventory, len(p.inventory))
+		for prop, state := range p.inventory {
+			inventory[prop] = state
+		}
+		s.players[i] = playerState{
+			position:     p.position,
+			money:        p.money,
+			inventory:    inventory,
+			roundsInJail: p.roundsInJail,
+			debts:        append([]debt(nil), p.debts...),
+			bankrupt:     p.bankrupt,
+			reserved:     p.reserved,
+			passedGo:     p.passedGo,
+		}
+	}
+
+	g.undo = append(g.undo, s)
+	if len(g.undo) > policy.Limit {
+		g.undo = g.undo[len(g.undo)-policy.Limit:]
+	}
+}
+
+// CanUndo reports whether there is an action that can be undone.
+func (g *Game) CanUndo() bool {
+	g.lock()
+	defer g.unlock()
+	return len(g.undo) != 0
+}
+
+// CanRedo reports whether there is an undone action that can be applied again.
+func (g *Game) CanRedo() bool {
+	g.lock()
+	defer g.unlock()
+	return len(g.redo) != 0
+}
+
+// Undo reverts the last action and returns it. Only actions since the last roll of the dice or any
+// other random draw can be undone and at most as many as [UndoPolicy.Limit] allows.
+func (g *Game) Undo() (Action, error) {
+	g.lock()
+	defer g.unlock()
+	if len(g.undo) == 0 {
+		return Action{}, ErrNothingToUndo
+	}
+	s := g.undo[len(g.undo)-1]
+
+	g.copyState(s.game)
+	g.undo = g.undo[:len(g.undo)-1]
+	g.redo = append(g.redo, s.action)
+	if g.log != nil {
+		g.log.Actions = g.log.Actions[:s.logLen]
+	}
+
+	for i, p := range g.players {
+		ps := s.players[i]
+		p.position = ps.position
+		p.money = ps.money
+		p.inventory = ps.inventory
+		p.roundsInJail = ps.roundsInJail
+		p.debts = ps.debts
+		p.bankrupt = ps.bankrupt
+		p.reserved = ps.reserved
+		p.passedGo = ps.passedGo
+	}
+	return s.action, nil
+}
+
+// Redo applies the last undone action again and returns it. Redo is only possible until another
+// action is taken.
+func (g *Game) Redo() (Action, error) {
+	g.lock()
+	defer g.unlock()
+	if len(g.redo) == 0 {
+		return Action{}, ErrNothingToRedo
+	}
+	a := g.redo[len(g.redo)-1]
+	g.redo = g.redo[:len(g.redo)-1]
+
+	g.redoing = true
+	err := g.apply(a)
+	g.redoing = false
+	if err != nil {
+		return Action{}, err
+	}
+	return a, nil
+}
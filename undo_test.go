@@ -0,0 +1,75 @@
+package monopoly
+
+import "testing"
+
+func TestGame_Undo(t *testing.T) {
+	rules := DefaultRules()
+	rules.Undo.Limit = 2
+	g := NewGame(rules, CAR, DOG)
+	p, _ := g.GetCurrentPlayer()
+	p.inventory[PARK_PLACE] = STATE_NORMAL
+	p.inventory[BOARDWALK] = STATE_NORMAL
+	p.inventory[MEDITERRANEAN_AVENUE] = STATE_NORMAL
+	g.state = GAME_TURN
+
+	for _, prop := range []Property{MEDITERRANEAN_AVENUE, PARK_PLACE, BOARDWALK} {
+		if err := p.MortgageProperty(prop); err != nil {
+			t.Fatalf("Player.MortgageProperty(%#v) got error: %v", prop, err)
+		}
+	}
+	for _, prop := range []Property{BOARDWALK, PARK_PLACE} {
+		a, err := g.Undo()
+		if err != nil || a.Kind != ACTION_MORTGAGE_PROPERTY || a.Property != prop {
+			t.Fatalf("Game.Undo() got (%#v, %v), want mortgage of %#v", a, err, prop)
+		}
+		if p.inventory[prop] != STATE_NORMAL {
+			t.Errorf("%#v after undo is in state %#v, want %#v", prop, p.inventory[prop], STATE_NORMAL)
+		}
+	}
+	if _, err := g.Undo(); err != ErrNothingToUndo {
+		t.Errorf("Game.Undo() beyond the limit got error %v, want %v", err, ErrNothingToUndo)
+	}
+	wantMoney := rules.StartMoney + g.board.MortgageValue(MEDITERRANEAN_AVENUE)
+	if p.money != wantMoney {
+		t.Errorf("Player after undo has %d money, want %d", p.money, wantMoney)
+	}
+	if log, _ := g.Log(); len(log.Actions) != 1 {
+		t.Errorf("Game.Log() after undo got %d actions, want 1", len(log.Actions))
+	}
+
+	if a, err := g.Redo(); err != nil || a.Property != PARK_PLACE || p.inventory[PARK_PLACE] != STATE_MORTGAGE {
+		t.Errorf("Game.Redo() got (%#v, %v), want mortgage of %#v", a, err, PARK_PLACE)
+	}
+	if err := p.MortgageProperty(BOARDWALK); err != nil {
+		t.Fatalf("Player.MortgageProperty() got error: %v", err)
+	}
+	if g.CanRedo() {
+		t.Error("Game.CanRedo() after a new action got true")
+	}
+
+	if _, err := p.EndTurn(); err != nil {
+		t.Fatalf("Player.EndTurn() got error: %v", err)
+	}
+	if g.CanUndo() {
+		t.Error("Game.CanUndo() after ending the turn got true")
+	}
+}
+
+func TestGame_Undo_diceRoll(t *testing.T) {
+	rules := DefaultRules()
+	rules.Undo.Limit = 10
+	g := NewGame(rules, CAR, DOG)
+	g.random = NewFixedDice(1, 2)
+	p, _ := g.GetCurrentPlayer()
+	p.inventory[BOARDWALK] = STATE_NORMAL
+
+	if err := p.MortgageProperty(BOARDWALK); err != nil {
+		t.Fatalf("Player.MortgageProperty() got error: %v", err)
+	}
+	if d1, d2, _, err := p.RollDice(); err != nil || d1 != 1 || d2 != 2 {
+		t.Fatalf("Player.RollDice() got (%d, %d, %v), want [1] [2]", d1, d2, err)
+	}
+	if _, err := g.Undo(); err != ErrNothingToUndo {
+		t.Errorf("Game.Undo() after rolling the dice got error %v, want %v", err, ErrNothingToUndo)
+	}
+}